@@ -39,6 +39,7 @@ func BuildBothApiCommand(name string, ui cli.Ui) cli.CommandFactory {
 		data["license_override"] = flags.String("license-override", "", "Override VMware license detection (standard or professional)")
 		data["timeout"] = flags.Duration("timeout", 120*time.Second, "Timeout for operation")
 		data["vmfolder"] = flags.String("vmfolder", utility.VMFolder(), "Location for vm")
+		data["configfolder"] = flags.String("configfolder", "", "Location for the pools, ipam and MAC reservations files")
 
 		if restCommand, err = BuildRestApiCommand("api", false, ui)(); err != nil {
 			return nil, err
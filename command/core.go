@@ -31,6 +31,7 @@ func Commands(name string, ui cli.Ui) (cmds map[string]cli.CommandFactory) {
 		"certificate get":      BuildCertificateGetCommand(name, ui),
 		"service install":      BuildServiceInstallCommand(name, ui),
 		"service uninstall":    BuildServiceUninstallCommand(name, ui),
+		"vmx restore":          BuildVmxRestoreCommand(name, ui),
 	}
 
 	platformSpecificCommands(name, ui, cmds)
@@ -279,6 +280,16 @@ func (c *Command) GetConfigDuration(name string, current *time.Duration) time.Du
 	return dval // default value
 }
 
+// Gets the warm pool sizes by template. Pools are only
+// supplied from the configuration file
+func (c *Command) GetConfigPools(current *map[string]int) map[string]int {
+	if current != nil {
+		return *current // config file set value
+	}
+
+	return map[string]int{} // default value
+}
+
 // Check if the value of a given flag is the default value
 func (c *Command) isDefaultValue(name string) bool {
 	name = strings.ReplaceAll(name, "_", "-")
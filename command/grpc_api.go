@@ -40,6 +40,7 @@ func BuildGrpcApiCommand(name string, ui cli.Ui) cli.CommandFactory {
 		data["license_override"] = flags.String("license-override", "", "Override VMware license detection (standard or professional)")
 		data["timeout"] = flags.Duration("timeout", 120*time.Second, "Timeout for operation")
 		data["vmfolder"] = flags.String("vmfolder", utility.VMFolder(), "Location for vm")
+		data["configfolder"] = flags.String("configfolder", "", "Location for the pools, ipam and MAC reservations files")
 
 		return &GrpcApiCommand{
 			Command: Command{
@@ -183,6 +184,8 @@ func (c *GrpcApiCommand) setup(args []string) (err error) {
 		c.Config.Listen = c.GetConfigValue("listen", rc.Plisten)
 		c.Config.Timeout = c.GetConfigDuration("timeout", rc.Ptimeout)
 		c.Config.VMFolder = c.GetConfigValue("vmfolder", rc.Pvmfolder)
+		c.Config.ConfigFolder = c.GetConfigValue("configfolder", rc.Pconfigfolder)
+		c.Config.Pools = c.GetConfigPools(rc.Ppools)
 		c.Config.VMRestURL = c.GetConfigValue("vmrest", rc.Pvmrest)
 		c.Config.Driver = c.GetConfigValue("driver", rc.Pdriver)
 		c.Config.LicenseOverride = c.GetConfigValue("license_override", rc.PlicenseOverride)
@@ -42,6 +42,7 @@ func BuildRestApiCommand(name string, waitRestApi bool, ui cli.Ui) cli.CommandFa
 		data["license_override"] = flags.String("license-override", "", "Override VMware license detection (standard or professional)")
 		data["timeout"] = flags.Duration("timeout", 120*time.Second, "Timeout for operation")
 		data["vmfolder"] = flags.String("vmfolder", utility.VMFolder(), "Location for vm")
+		data["configfolder"] = flags.String("configfolder", "", "Location for the pools, ipam and MAC reservations files")
 		data["vmrest"] = flags.String("vmrest", DEFAULT_VMREST_ADDRESS, "Address for external vmrest api when driver is not vmrest")
 
 		return &RestApiCommand{
@@ -148,6 +149,8 @@ func (c *RestApiCommand) setup(args []string) (err error) {
 	c.Config.Address = c.GetConfigValue("address", rc.Paddress)
 	c.Config.Timeout = c.GetConfigDuration("timeout", rc.Ptimeout)
 	c.Config.VMFolder = c.GetConfigValue("vmfolder", rc.Pvmfolder)
+	c.Config.ConfigFolder = c.GetConfigValue("configfolder", rc.Pconfigfolder)
+	c.Config.Pools = c.GetConfigPools(rc.Ppools)
 	c.Config.VMRestURL = c.GetConfigValue("vmrest", rc.Pvmrest)
 	c.Config.Port = c.GetConfigInt64("port", rc.Pport)
 	c.Config.Driver = c.GetConfigValue("driver", rc.Pdriver)
@@ -55,6 +55,7 @@ func BuildServiceInstallCommand(name string, ui cli.Ui) cli.CommandFactory {
 		data["config_write"] = flags.String("config-write", "./service.hcl", "Path to write configuration file (used for print only)")
 		data["timeout"] = flags.Duration("timeout", 120*time.Second, "Timeout for operation")
 		data["vmfolder"] = flags.String("vmfolder", utility.VMFolder(), "Location for vm")
+		data["configfolder"] = flags.String("configfolder", "", "Location for the pools, ipam and MAC reservations files")
 
 		return &ServiceInstallCommand{
 			Command: Command{
@@ -127,6 +128,8 @@ func (c *ServiceInstallCommand) setup(args []string) (err error) {
 	c.Config.Print = c.GetConfigBool("print", nil)
 	c.Config.Timeout = c.GetConfigDuration("timeout", sc.Ptimeout)
 	c.Config.VMFolder = c.GetConfigValue("vmfolder", sc.Pvmfolder)
+	c.Config.ConfigFolder = c.GetConfigValue("configfolder", sc.Pconfigfolder)
+	c.Config.Pools = c.GetConfigPools(sc.Ppools)
 	c.Config.VMRestURL = c.GetConfigValue("vmrest", sc.Pvmrest)
 
 	return
@@ -172,10 +175,18 @@ func (c *ServiceInstallCommand) writeConfig(fpath string) (cpath string, err err
 		config.ConfigFile.Pvmfolder = &c.Config.VMFolder
 	}
 
+	if c.Config.ConfigFolder != "" {
+		config.ConfigFile.Pconfigfolder = &c.Config.ConfigFolder
+	}
+
 	if c.Config.VMRestURL != "" {
 		config.ConfigFile.Pvmrest = &c.Config.VMRestURL
 	}
 
+	if len(c.Config.Pools) > 0 {
+		config.ConfigFile.Ppools = &c.Config.Pools
+	}
+
 	if c.Config.RunitDir != "" {
 		config.ConfigFile.PrunitDir = &c.Config.RunitDir
 	}
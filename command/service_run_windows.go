@@ -39,6 +39,7 @@ func BuildServiceRunCommand(name string, ui cli.Ui) cli.CommandFactory {
 		data["license_override"] = flags.String("license-override", "", "Override VMware license detection (standard or professional)")
 		data["timeout"] = flags.Duration("timeout", 120*time.Second, "Timeout for operation")
 		data["vmfolder"] = flags.String("vmfolder", utility.VMFolder(), "Location for vm")
+		data["configfolder"] = flags.String("configfolder", "", "Location for the pools, ipam and MAC reservations files")
 
 		return &ServiceRunCommand{
 			RestApiCommand: RestApiCommand{
@@ -159,6 +160,8 @@ func (c *ServiceRunCommand) setup(args []string) (err error) {
 	c.Config.Port = c.GetConfigInt64("port", rc.Pport)
 	c.Config.Driver = c.GetConfigValue("driver", rc.Pdriver)
 	c.Config.VMFolder = c.GetConfigValue("vmfolder", rc.Pvmfolder)
+	c.Config.ConfigFolder = c.GetConfigValue("configfolder", rc.Pconfigfolder)
+	c.Config.Pools = c.GetConfigPools(rc.Ppools)
 	c.Config.VMRestURL = c.GetConfigValue("vmrest", rc.Pvmrest)
 	c.Config.LicenseOverride = c.GetConfigValue("license_override", rc.PlicenseOverride)
 	c.Config.LogDisplay = c.DefaultConfig.LogFile != ""
@@ -0,0 +1,112 @@
+package command
+
+import (
+	"flag"
+	"fmt"
+	"path/filepath"
+
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/service"
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/utils"
+	vagrant_utility "github.com/hashicorp/vagrant-vmware-desktop/go_src/vagrant-vmware-utility/utility"
+	"github.com/mitchellh/cli"
+)
+
+// Command to roll back the last edits of a VMX from its backups
+type VmxRestoreCommand struct {
+	Command
+	generations int64
+	list        bool
+}
+
+func BuildVmxRestoreCommand(name string, ui cli.Ui) cli.CommandFactory {
+	return func() (cli.Command, error) {
+		flags := flag.NewFlagSet("vmx restore", flag.ContinueOnError)
+		data := make(map[string]interface{})
+		setDefaultFlags(flags, data)
+
+		data["generations"] = flags.Int64("generations", 1, fmt.Sprintf("Count of edits to roll back, up to %d", utils.VMXBackupGenerations))
+		data["list"] = flags.Bool("list", false, "List the available backups")
+
+		return &VmxRestoreCommand{
+			Command: Command{
+				DefaultConfig: &Config{},
+				Name:          name,
+				Flags:         flags,
+				HelpText:      name + " vmx restore [options] <path to vmx>",
+				SynopsisText:  "Roll back the last edits of a VMX, the VM must be powered off",
+				UI:            ui,
+				flagdata:      data,
+			},
+		}, nil
+	}
+}
+
+func (c *VmxRestoreCommand) Run(args []string) int {
+	exitCode := 1
+	err := c.setup(args)
+
+	if err != nil {
+		c.UI.Error("Failed to initialize: " + err.Error())
+		return exitCode
+	}
+
+	if c.Flags.NArg() != 1 {
+		c.UI.Error("Expected the path of the VMX")
+		c.UI.Output(c.Help())
+		return exitCode
+	}
+
+	vmxpath := c.Flags.Arg(0)
+
+	if !utils.FileExists(vmxpath) {
+		c.UI.Error("VMX not found: " + vmxpath)
+		return exitCode
+	}
+
+	if c.list {
+		for generation, backup := range utils.VMXBackups(vmxpath) {
+			c.UI.Output(fmt.Sprintf("%d: %s", generation+1, backup))
+		}
+
+		return 0
+	}
+
+	if powered, err := c.powered(vmxpath); err != nil {
+		c.UI.Error("Unable to check the VM is powered off: " + err.Error())
+		return exitCode
+	} else if powered {
+		c.UI.Error("VM is powered, power off before restore: " + vmxpath)
+		return exitCode
+	}
+
+	if err = utils.RestoreVMX(vmxpath, int(c.generations)); err != nil {
+		c.UI.Error("VMX restore failed: " + err.Error())
+		return exitCode
+	}
+
+	c.UI.Info(fmt.Sprintf("Rolled back %d edits of: %s", c.generations, vmxpath))
+
+	return 0
+}
+
+// powered check if vmrun list report the VMX as running or paused
+func (c *VmxRestoreCommand) powered(vmxpath string) (bool, error) {
+	if abspath, err := filepath.Abs(vmxpath); err != nil {
+		return false, err
+	} else if paths, err := vagrant_utility.LoadVmwarePaths(c.logger); err != nil {
+		return false, err
+	} else if running, err := service.ListRunningVMX(paths.Vmrun, c.logger); err != nil {
+		return false, err
+	} else {
+		return running[abspath], nil
+	}
+}
+
+func (c *VmxRestoreCommand) setup(args []string) (err error) {
+	if err = c.defaultSetup(args); err == nil {
+		c.generations = c.GetConfigInt64("generations", nil)
+		c.list = c.GetConfigBool("list", nil)
+	}
+
+	return
+}
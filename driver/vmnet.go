@@ -0,0 +1,188 @@
+package driver
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/status"
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/utils"
+	vagrant_driver "github.com/hashicorp/vagrant-vmware-desktop/go_src/vagrant-vmware-utility/driver"
+	codes "google.golang.org/grpc/codes"
+)
+
+// Types of the vmnet managed by the utility, spelled like vmrest
+const (
+	VmnetHostOnly = "hostOnly"
+	VmnetNAT      = "nat"
+)
+
+// FindVmnet return the vmnet named name or nil
+func FindVmnet(vmnets *vagrant_driver.Vmnets, name string) *vagrant_driver.Vmnet {
+	for _, vmnet := range vmnets.Vmnets {
+		if vmnet.Name == name {
+			return vmnet
+		}
+	}
+
+	return nil
+}
+
+// vmnetSubnet parse the subnet and the mask of a vmnet, nil if the vmnet has no subnet
+func vmnetSubnet(vmnet *vagrant_driver.Vmnet) (*net.IPNet, error) {
+	if vmnet.Subnet == "" && vmnet.Mask == "" {
+		return nil, nil
+	} else if vmnet.Subnet == "" || vmnet.Mask == "" {
+		return nil, fmt.Errorf("subnet and mask must be given together for vmnet: %s", vmnet.Name)
+	}
+
+	ip := net.ParseIP(vmnet.Subnet).To4()
+	mask := net.ParseIP(vmnet.Mask).To4()
+
+	if ip == nil {
+		return nil, fmt.Errorf("invalid subnet: %s for vmnet: %s", vmnet.Subnet, vmnet.Name)
+	} else if mask == nil {
+		return nil, fmt.Errorf("invalid mask: %s for vmnet: %s", vmnet.Mask, vmnet.Name)
+	}
+
+	ipmask := net.IPMask(mask)
+
+	if ones, bits := ipmask.Size(); bits == 0 || ones == 0 {
+		return nil, fmt.Errorf("invalid mask: %s for vmnet: %s", vmnet.Mask, vmnet.Name)
+	} else if !ip.Mask(ipmask).Equal(ip) {
+		return nil, fmt.Errorf("subnet: %s is not the network address of mask: %s for vmnet: %s", vmnet.Subnet, vmnet.Mask, vmnet.Name)
+	}
+
+	return &net.IPNet{IP: ip, Mask: ipmask}, nil
+}
+
+// PrepareVmnet normalize and validate a host-only or NAT vmnet, the subnet must not overlap the subnet of another vmnet.
+// An existing vmnet with the same name is the one being updated, it's ignored.
+func PrepareVmnet(vmnets *vagrant_driver.Vmnets, vnet *vagrant_driver.Vmnet) error {
+	switch strings.ToLower(vnet.Type) {
+	case "", "hostonly", "host-only":
+		vnet.Type = VmnetHostOnly
+	case VmnetNAT:
+		vnet.Type = VmnetNAT
+	default:
+		return fmt.Errorf("invalid type: %s for vmnet: %s, expected %s or %s", vnet.Type, vnet.Name, VmnetHostOnly, VmnetNAT)
+	}
+
+	if vnet.Dhcp != "" {
+		vnet.Dhcp = utils.BoolToStr(utils.StrToBool(vnet.Dhcp))
+	}
+
+	subnet, err := vmnetSubnet(vnet)
+
+	if err != nil || subnet == nil {
+		return err
+	}
+
+	for _, vmnet := range vmnets.Vmnets {
+		if vmnet.Name != vnet.Name {
+			// Subnets of the existing vmnets are trusted, the bad ones are skipped
+			if other, _ := vmnetSubnet(vmnet); other != nil && (other.Contains(subnet.IP) || subnet.Contains(other.IP)) {
+				return fmt.Errorf("subnet: %s of vmnet: %s overlap the subnet: %s of vmnet: %s", subnet, vnet.Name, other, vmnet.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// VmnetByName return the vmnet named name
+func VmnetByName(d Driver, name string) (*vagrant_driver.Vmnet, error) {
+	defer d.GetVmrun().RLockVmnet(name)()
+
+	if vmnets, err := d.GetDriver().Vmnets(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list vmnets, reason: %v", err)
+	} else if vnet := FindVmnet(vmnets, name); vnet == nil {
+		return nil, status.Errorf(codes.NotFound, "vmnet: %s, not found", name)
+	} else {
+		return vnet, nil
+	}
+}
+
+// ListVmnets return the vmnets of the host
+func ListVmnets(d Driver) (*vagrant_driver.Vmnets, error) {
+	defer d.GetVmrun().RLockVmnet("")()
+
+	if vmnets, err := d.GetDriver().Vmnets(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list vmnets, reason: %v", err)
+	} else {
+		return vmnets, nil
+	}
+}
+
+// CreateVmnet create a host-only or NAT vmnet, the vmnets inventory is locked to validate the subnet and pick the name
+func CreateVmnet(d Driver, vnet *vagrant_driver.Vmnet) error {
+	defer d.GetVmrun().LockVmnet("")()
+
+	if vmnets, err := d.GetDriver().Vmnets(); err != nil {
+		return status.Errorf(codes.Internal, "failed to list vmnets, reason: %v", err)
+	} else if vnet.Name != "" && FindVmnet(vmnets, vnet.Name) != nil {
+		return status.Errorf(codes.AlreadyExists, "vmnet: %s, already exists", vnet.Name)
+	} else if err = PrepareVmnet(vmnets, vnet); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	} else if err = d.GetDriver().AddVmnet(vnet); err != nil {
+		return status.Errorf(codes.Internal, "failed to create vmnet: %s, reason: %v", vnet.Name, err)
+	}
+
+	return nil
+}
+
+// UpdateVmnet change the type, the DHCP or the subnet of a vmnet, the fields not given are left unchanged.
+// Only a subnet change lock the vmnets inventory, the others changes lock the vmnet.
+func UpdateVmnet(d Driver, name string, update *vagrant_driver.Vmnet) (*vagrant_driver.Vmnet, error) {
+	subnetChanged := update.Subnet != "" || update.Mask != ""
+
+	if subnetChanged {
+		defer d.GetVmrun().LockVmnet("")()
+	} else {
+		defer d.GetVmrun().LockVmnet(name)()
+	}
+
+	if vmnets, err := d.GetDriver().Vmnets(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list vmnets, reason: %v", err)
+	} else if existing := FindVmnet(vmnets, name); existing == nil {
+		return nil, status.Errorf(codes.NotFound, "vmnet: %s, not found", name)
+	} else {
+		vnet := *existing
+
+		if update.Type != "" {
+			vnet.Type = update.Type
+		}
+
+		if update.Dhcp != "" {
+			vnet.Dhcp = update.Dhcp
+		}
+
+		if subnetChanged {
+			vnet.Subnet = update.Subnet
+			vnet.Mask = update.Mask
+		}
+
+		if err = PrepareVmnet(vmnets, &vnet); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		} else if err = d.GetDriver().UpdateVmnet(&vnet); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to update vmnet: %s, reason: %v", name, err)
+		}
+
+		return &vnet, nil
+	}
+}
+
+// DeleteVmnet delete a vmnet
+func DeleteVmnet(d Driver, name string) error {
+	defer d.GetVmrun().LockVmnet(name)()
+
+	if vmnets, err := d.GetDriver().Vmnets(); err != nil {
+		return status.Errorf(codes.Internal, "failed to list vmnets, reason: %v", err)
+	} else if vnet := FindVmnet(vmnets, name); vnet == nil {
+		return status.Errorf(codes.NotFound, "vmnet: %s, not found", name)
+	} else if err = d.GetDriver().DeleteVmnet(vnet); err != nil {
+		return status.Errorf(codes.Internal, "failed to delete vmnet: %s, reason: %v", name, err)
+	}
+
+	return nil
+}
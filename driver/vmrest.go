@@ -661,6 +661,7 @@ func (v *VmrestDriver) AddVmnet(vnet *vagrant_driver.Vmnet) error {
 			return err
 		} else if _, err := v.Do("post", "vmnets", bytes.NewBuffer(f)); err != nil {
 			v.logger.Error("failed to create new network", "vmnet", vnet, "error", err)
+			return err
 		}
 		return nil
 	}
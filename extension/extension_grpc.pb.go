@@ -0,0 +1,1470 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.21.12
+// source: extension.proto
+
+package extension
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	VMWareDesktopAutoscalerExtensionService_ListSnapshots_FullMethodName          = "/extension.VMWareDesktopAutoscalerExtensionService/ListSnapshots"
+	VMWareDesktopAutoscalerExtensionService_TakeSnapshot_FullMethodName           = "/extension.VMWareDesktopAutoscalerExtensionService/TakeSnapshot"
+	VMWareDesktopAutoscalerExtensionService_RevertToSnapshot_FullMethodName       = "/extension.VMWareDesktopAutoscalerExtensionService/RevertToSnapshot"
+	VMWareDesktopAutoscalerExtensionService_DeleteSnapshot_FullMethodName         = "/extension.VMWareDesktopAutoscalerExtensionService/DeleteSnapshot"
+	VMWareDesktopAutoscalerExtensionService_Suspend_FullMethodName                = "/extension.VMWareDesktopAutoscalerExtensionService/Suspend"
+	VMWareDesktopAutoscalerExtensionService_Pause_FullMethodName                  = "/extension.VMWareDesktopAutoscalerExtensionService/Pause"
+	VMWareDesktopAutoscalerExtensionService_Unpause_FullMethodName                = "/extension.VMWareDesktopAutoscalerExtensionService/Unpause"
+	VMWareDesktopAutoscalerExtensionService_Reset_FullMethodName                  = "/extension.VMWareDesktopAutoscalerExtensionService/Reset"
+	VMWareDesktopAutoscalerExtensionService_ListPools_FullMethodName              = "/extension.VMWareDesktopAutoscalerExtensionService/ListPools"
+	VMWareDesktopAutoscalerExtensionService_Reconfigure_FullMethodName            = "/extension.VMWareDesktopAutoscalerExtensionService/Reconfigure"
+	VMWareDesktopAutoscalerExtensionService_ListDisks_FullMethodName              = "/extension.VMWareDesktopAutoscalerExtensionService/ListDisks"
+	VMWareDesktopAutoscalerExtensionService_AddDisk_FullMethodName                = "/extension.VMWareDesktopAutoscalerExtensionService/AddDisk"
+	VMWareDesktopAutoscalerExtensionService_RemoveDisk_FullMethodName             = "/extension.VMWareDesktopAutoscalerExtensionService/RemoveDisk"
+	VMWareDesktopAutoscalerExtensionService_RunProgramInGuest_FullMethodName      = "/extension.VMWareDesktopAutoscalerExtensionService/RunProgramInGuest"
+	VMWareDesktopAutoscalerExtensionService_RunScriptInGuest_FullMethodName       = "/extension.VMWareDesktopAutoscalerExtensionService/RunScriptInGuest"
+	VMWareDesktopAutoscalerExtensionService_CopyFileToGuest_FullMethodName        = "/extension.VMWareDesktopAutoscalerExtensionService/CopyFileToGuest"
+	VMWareDesktopAutoscalerExtensionService_CopyFileFromGuest_FullMethodName      = "/extension.VMWareDesktopAutoscalerExtensionService/CopyFileFromGuest"
+	VMWareDesktopAutoscalerExtensionService_ListProcessesInGuest_FullMethodName   = "/extension.VMWareDesktopAutoscalerExtensionService/ListProcessesInGuest"
+	VMWareDesktopAutoscalerExtensionService_ListGuestInfos_FullMethodName         = "/extension.VMWareDesktopAutoscalerExtensionService/ListGuestInfos"
+	VMWareDesktopAutoscalerExtensionService_GetGuestInfo_FullMethodName           = "/extension.VMWareDesktopAutoscalerExtensionService/GetGuestInfo"
+	VMWareDesktopAutoscalerExtensionService_SetGuestInfo_FullMethodName           = "/extension.VMWareDesktopAutoscalerExtensionService/SetGuestInfo"
+	VMWareDesktopAutoscalerExtensionService_WatchEvents_FullMethodName            = "/extension.VMWareDesktopAutoscalerExtensionService/WatchEvents"
+	VMWareDesktopAutoscalerExtensionService_RemoveNetworkInterface_FullMethodName = "/extension.VMWareDesktopAutoscalerExtensionService/RemoveNetworkInterface"
+	VMWareDesktopAutoscalerExtensionService_ListVmnets_FullMethodName             = "/extension.VMWareDesktopAutoscalerExtensionService/ListVmnets"
+	VMWareDesktopAutoscalerExtensionService_GetVmnet_FullMethodName               = "/extension.VMWareDesktopAutoscalerExtensionService/GetVmnet"
+	VMWareDesktopAutoscalerExtensionService_CreateVmnet_FullMethodName            = "/extension.VMWareDesktopAutoscalerExtensionService/CreateVmnet"
+	VMWareDesktopAutoscalerExtensionService_UpdateVmnet_FullMethodName            = "/extension.VMWareDesktopAutoscalerExtensionService/UpdateVmnet"
+	VMWareDesktopAutoscalerExtensionService_DeleteVmnet_FullMethodName            = "/extension.VMWareDesktopAutoscalerExtensionService/DeleteVmnet"
+	VMWareDesktopAutoscalerExtensionService_ListPortForwards_FullMethodName       = "/extension.VMWareDesktopAutoscalerExtensionService/ListPortForwards"
+	VMWareDesktopAutoscalerExtensionService_AddPortForward_FullMethodName         = "/extension.VMWareDesktopAutoscalerExtensionService/AddPortForward"
+	VMWareDesktopAutoscalerExtensionService_DeletePortForward_FullMethodName      = "/extension.VMWareDesktopAutoscalerExtensionService/DeletePortForward"
+	VMWareDesktopAutoscalerExtensionService_ListIPAllocations_FullMethodName      = "/extension.VMWareDesktopAutoscalerExtensionService/ListIPAllocations"
+	VMWareDesktopAutoscalerExtensionService_AllocateIP_FullMethodName             = "/extension.VMWareDesktopAutoscalerExtensionService/AllocateIP"
+	VMWareDesktopAutoscalerExtensionService_ReleaseIP_FullMethodName              = "/extension.VMWareDesktopAutoscalerExtensionService/ReleaseIP"
+	VMWareDesktopAutoscalerExtensionService_WaitForIP_FullMethodName              = "/extension.VMWareDesktopAutoscalerExtensionService/WaitForIP"
+	VMWareDesktopAutoscalerExtensionService_RestoreVMX_FullMethodName             = "/extension.VMWareDesktopAutoscalerExtensionService/RestoreVMX"
+	VMWareDesktopAutoscalerExtensionService_PreviewCreate_FullMethodName          = "/extension.VMWareDesktopAutoscalerExtensionService/PreviewCreate"
+)
+
+// VMWareDesktopAutoscalerExtensionServiceClient is the client API for VMWareDesktopAutoscalerExtensionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type VMWareDesktopAutoscalerExtensionServiceClient interface {
+	ListSnapshots(ctx context.Context, in *VirtualMachineRequest, opts ...grpc.CallOption) (*ListSnapshotsResponse, error)
+	TakeSnapshot(ctx context.Context, in *TakeSnapshotRequest, opts ...grpc.CallOption) (*TakeSnapshotResponse, error)
+	RevertToSnapshot(ctx context.Context, in *SnapshotRequest, opts ...grpc.CallOption) (*DoneResponse, error)
+	DeleteSnapshot(ctx context.Context, in *DeleteSnapshotRequest, opts ...grpc.CallOption) (*DoneResponse, error)
+	Suspend(ctx context.Context, in *PowerModeRequest, opts ...grpc.CallOption) (*DoneResponse, error)
+	Pause(ctx context.Context, in *VirtualMachineRequest, opts ...grpc.CallOption) (*DoneResponse, error)
+	Unpause(ctx context.Context, in *VirtualMachineRequest, opts ...grpc.CallOption) (*DoneResponse, error)
+	Reset(ctx context.Context, in *PowerModeRequest, opts ...grpc.CallOption) (*DoneResponse, error)
+	ListPools(ctx context.Context, in *PoolsRequest, opts ...grpc.CallOption) (*PoolsResponse, error)
+	Reconfigure(ctx context.Context, in *ReconfigureRequest, opts ...grpc.CallOption) (*VirtualMachineResponse, error)
+	ListDisks(ctx context.Context, in *VirtualMachineRequest, opts ...grpc.CallOption) (*ListDisksResponse, error)
+	AddDisk(ctx context.Context, in *AddDiskRequest, opts ...grpc.CallOption) (*AddDiskResponse, error)
+	RemoveDisk(ctx context.Context, in *RemoveDiskRequest, opts ...grpc.CallOption) (*DoneResponse, error)
+	RunProgramInGuest(ctx context.Context, in *RunProgramInGuestRequest, opts ...grpc.CallOption) (*ExitCodeResponse, error)
+	RunScriptInGuest(ctx context.Context, in *RunScriptInGuestRequest, opts ...grpc.CallOption) (*ExitCodeResponse, error)
+	CopyFileToGuest(ctx context.Context, in *CopyFileToGuestRequest, opts ...grpc.CallOption) (*DoneResponse, error)
+	CopyFileFromGuest(ctx context.Context, in *CopyFileFromGuestRequest, opts ...grpc.CallOption) (*CopyFileFromGuestResponse, error)
+	ListProcessesInGuest(ctx context.Context, in *GuestRequest, opts ...grpc.CallOption) (*ListProcessesInGuestResponse, error)
+	ListGuestInfos(ctx context.Context, in *VirtualMachineRequest, opts ...grpc.CallOption) (*ListGuestInfosResponse, error)
+	GetGuestInfo(ctx context.Context, in *GuestInfoRequest, opts ...grpc.CallOption) (*GuestInfoResponse, error)
+	SetGuestInfo(ctx context.Context, in *SetGuestInfoRequest, opts ...grpc.CallOption) (*DoneResponse, error)
+	WatchEvents(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (VMWareDesktopAutoscalerExtensionService_WatchEventsClient, error)
+	RemoveNetworkInterface(ctx context.Context, in *NetworkInterfaceRequest, opts ...grpc.CallOption) (*DoneResponse, error)
+	ListVmnets(ctx context.Context, in *VmnetsRequest, opts ...grpc.CallOption) (*VmnetsResponse, error)
+	GetVmnet(ctx context.Context, in *VmnetRequest, opts ...grpc.CallOption) (*VmnetResponse, error)
+	CreateVmnet(ctx context.Context, in *Vmnet, opts ...grpc.CallOption) (*VmnetResponse, error)
+	UpdateVmnet(ctx context.Context, in *Vmnet, opts ...grpc.CallOption) (*VmnetResponse, error)
+	DeleteVmnet(ctx context.Context, in *VmnetRequest, opts ...grpc.CallOption) (*DoneResponse, error)
+	ListPortForwards(ctx context.Context, in *VirtualMachineRequest, opts ...grpc.CallOption) (*ListPortForwardsResponse, error)
+	AddPortForward(ctx context.Context, in *AddPortForwardRequest, opts ...grpc.CallOption) (*PortForwardResponse, error)
+	DeletePortForward(ctx context.Context, in *DeletePortForwardRequest, opts ...grpc.CallOption) (*DoneResponse, error)
+	ListIPAllocations(ctx context.Context, in *IPAllocationsRequest, opts ...grpc.CallOption) (*IPAllocationsResponse, error)
+	AllocateIP(ctx context.Context, in *IPAllocation, opts ...grpc.CallOption) (*IPAllocationResponse, error)
+	ReleaseIP(ctx context.Context, in *IPAllocation, opts ...grpc.CallOption) (*DoneResponse, error)
+	WaitForIP(ctx context.Context, in *WaitForIPRequest, opts ...grpc.CallOption) (*WaitForIPResponse, error)
+	RestoreVMX(ctx context.Context, in *RestoreVMXRequest, opts ...grpc.CallOption) (*VirtualMachineResponse, error)
+	PreviewCreate(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*PreviewCreateResponse, error)
+}
+
+type vMWareDesktopAutoscalerExtensionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewVMWareDesktopAutoscalerExtensionServiceClient(cc grpc.ClientConnInterface) VMWareDesktopAutoscalerExtensionServiceClient {
+	return &vMWareDesktopAutoscalerExtensionServiceClient{cc}
+}
+
+func (c *vMWareDesktopAutoscalerExtensionServiceClient) ListSnapshots(ctx context.Context, in *VirtualMachineRequest, opts ...grpc.CallOption) (*ListSnapshotsResponse, error) {
+	out := new(ListSnapshotsResponse)
+	err := c.cc.Invoke(ctx, VMWareDesktopAutoscalerExtensionService_ListSnapshots_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMWareDesktopAutoscalerExtensionServiceClient) TakeSnapshot(ctx context.Context, in *TakeSnapshotRequest, opts ...grpc.CallOption) (*TakeSnapshotResponse, error) {
+	out := new(TakeSnapshotResponse)
+	err := c.cc.Invoke(ctx, VMWareDesktopAutoscalerExtensionService_TakeSnapshot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMWareDesktopAutoscalerExtensionServiceClient) RevertToSnapshot(ctx context.Context, in *SnapshotRequest, opts ...grpc.CallOption) (*DoneResponse, error) {
+	out := new(DoneResponse)
+	err := c.cc.Invoke(ctx, VMWareDesktopAutoscalerExtensionService_RevertToSnapshot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMWareDesktopAutoscalerExtensionServiceClient) DeleteSnapshot(ctx context.Context, in *DeleteSnapshotRequest, opts ...grpc.CallOption) (*DoneResponse, error) {
+	out := new(DoneResponse)
+	err := c.cc.Invoke(ctx, VMWareDesktopAutoscalerExtensionService_DeleteSnapshot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMWareDesktopAutoscalerExtensionServiceClient) Suspend(ctx context.Context, in *PowerModeRequest, opts ...grpc.CallOption) (*DoneResponse, error) {
+	out := new(DoneResponse)
+	err := c.cc.Invoke(ctx, VMWareDesktopAutoscalerExtensionService_Suspend_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMWareDesktopAutoscalerExtensionServiceClient) Pause(ctx context.Context, in *VirtualMachineRequest, opts ...grpc.CallOption) (*DoneResponse, error) {
+	out := new(DoneResponse)
+	err := c.cc.Invoke(ctx, VMWareDesktopAutoscalerExtensionService_Pause_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMWareDesktopAutoscalerExtensionServiceClient) Unpause(ctx context.Context, in *VirtualMachineRequest, opts ...grpc.CallOption) (*DoneResponse, error) {
+	out := new(DoneResponse)
+	err := c.cc.Invoke(ctx, VMWareDesktopAutoscalerExtensionService_Unpause_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMWareDesktopAutoscalerExtensionServiceClient) Reset(ctx context.Context, in *PowerModeRequest, opts ...grpc.CallOption) (*DoneResponse, error) {
+	out := new(DoneResponse)
+	err := c.cc.Invoke(ctx, VMWareDesktopAutoscalerExtensionService_Reset_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMWareDesktopAutoscalerExtensionServiceClient) ListPools(ctx context.Context, in *PoolsRequest, opts ...grpc.CallOption) (*PoolsResponse, error) {
+	out := new(PoolsResponse)
+	err := c.cc.Invoke(ctx, VMWareDesktopAutoscalerExtensionService_ListPools_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMWareDesktopAutoscalerExtensionServiceClient) Reconfigure(ctx context.Context, in *ReconfigureRequest, opts ...grpc.CallOption) (*VirtualMachineResponse, error) {
+	out := new(VirtualMachineResponse)
+	err := c.cc.Invoke(ctx, VMWareDesktopAutoscalerExtensionService_Reconfigure_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMWareDesktopAutoscalerExtensionServiceClient) ListDisks(ctx context.Context, in *VirtualMachineRequest, opts ...grpc.CallOption) (*ListDisksResponse, error) {
+	out := new(ListDisksResponse)
+	err := c.cc.Invoke(ctx, VMWareDesktopAutoscalerExtensionService_ListDisks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMWareDesktopAutoscalerExtensionServiceClient) AddDisk(ctx context.Context, in *AddDiskRequest, opts ...grpc.CallOption) (*AddDiskResponse, error) {
+	out := new(AddDiskResponse)
+	err := c.cc.Invoke(ctx, VMWareDesktopAutoscalerExtensionService_AddDisk_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMWareDesktopAutoscalerExtensionServiceClient) RemoveDisk(ctx context.Context, in *RemoveDiskRequest, opts ...grpc.CallOption) (*DoneResponse, error) {
+	out := new(DoneResponse)
+	err := c.cc.Invoke(ctx, VMWareDesktopAutoscalerExtensionService_RemoveDisk_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMWareDesktopAutoscalerExtensionServiceClient) RunProgramInGuest(ctx context.Context, in *RunProgramInGuestRequest, opts ...grpc.CallOption) (*ExitCodeResponse, error) {
+	out := new(ExitCodeResponse)
+	err := c.cc.Invoke(ctx, VMWareDesktopAutoscalerExtensionService_RunProgramInGuest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMWareDesktopAutoscalerExtensionServiceClient) RunScriptInGuest(ctx context.Context, in *RunScriptInGuestRequest, opts ...grpc.CallOption) (*ExitCodeResponse, error) {
+	out := new(ExitCodeResponse)
+	err := c.cc.Invoke(ctx, VMWareDesktopAutoscalerExtensionService_RunScriptInGuest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMWareDesktopAutoscalerExtensionServiceClient) CopyFileToGuest(ctx context.Context, in *CopyFileToGuestRequest, opts ...grpc.CallOption) (*DoneResponse, error) {
+	out := new(DoneResponse)
+	err := c.cc.Invoke(ctx, VMWareDesktopAutoscalerExtensionService_CopyFileToGuest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMWareDesktopAutoscalerExtensionServiceClient) CopyFileFromGuest(ctx context.Context, in *CopyFileFromGuestRequest, opts ...grpc.CallOption) (*CopyFileFromGuestResponse, error) {
+	out := new(CopyFileFromGuestResponse)
+	err := c.cc.Invoke(ctx, VMWareDesktopAutoscalerExtensionService_CopyFileFromGuest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMWareDesktopAutoscalerExtensionServiceClient) ListProcessesInGuest(ctx context.Context, in *GuestRequest, opts ...grpc.CallOption) (*ListProcessesInGuestResponse, error) {
+	out := new(ListProcessesInGuestResponse)
+	err := c.cc.Invoke(ctx, VMWareDesktopAutoscalerExtensionService_ListProcessesInGuest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMWareDesktopAutoscalerExtensionServiceClient) ListGuestInfos(ctx context.Context, in *VirtualMachineRequest, opts ...grpc.CallOption) (*ListGuestInfosResponse, error) {
+	out := new(ListGuestInfosResponse)
+	err := c.cc.Invoke(ctx, VMWareDesktopAutoscalerExtensionService_ListGuestInfos_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMWareDesktopAutoscalerExtensionServiceClient) GetGuestInfo(ctx context.Context, in *GuestInfoRequest, opts ...grpc.CallOption) (*GuestInfoResponse, error) {
+	out := new(GuestInfoResponse)
+	err := c.cc.Invoke(ctx, VMWareDesktopAutoscalerExtensionService_GetGuestInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMWareDesktopAutoscalerExtensionServiceClient) SetGuestInfo(ctx context.Context, in *SetGuestInfoRequest, opts ...grpc.CallOption) (*DoneResponse, error) {
+	out := new(DoneResponse)
+	err := c.cc.Invoke(ctx, VMWareDesktopAutoscalerExtensionService_SetGuestInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMWareDesktopAutoscalerExtensionServiceClient) WatchEvents(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (VMWareDesktopAutoscalerExtensionService_WatchEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &VMWareDesktopAutoscalerExtensionService_ServiceDesc.Streams[0], VMWareDesktopAutoscalerExtensionService_WatchEvents_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vMWareDesktopAutoscalerExtensionServiceWatchEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type VMWareDesktopAutoscalerExtensionService_WatchEventsClient interface {
+	Recv() (*Event, error)
+	grpc.ClientStream
+}
+
+type vMWareDesktopAutoscalerExtensionServiceWatchEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *vMWareDesktopAutoscalerExtensionServiceWatchEventsClient) Recv() (*Event, error) {
+	m := new(Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vMWareDesktopAutoscalerExtensionServiceClient) RemoveNetworkInterface(ctx context.Context, in *NetworkInterfaceRequest, opts ...grpc.CallOption) (*DoneResponse, error) {
+	out := new(DoneResponse)
+	err := c.cc.Invoke(ctx, VMWareDesktopAutoscalerExtensionService_RemoveNetworkInterface_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMWareDesktopAutoscalerExtensionServiceClient) ListVmnets(ctx context.Context, in *VmnetsRequest, opts ...grpc.CallOption) (*VmnetsResponse, error) {
+	out := new(VmnetsResponse)
+	err := c.cc.Invoke(ctx, VMWareDesktopAutoscalerExtensionService_ListVmnets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMWareDesktopAutoscalerExtensionServiceClient) GetVmnet(ctx context.Context, in *VmnetRequest, opts ...grpc.CallOption) (*VmnetResponse, error) {
+	out := new(VmnetResponse)
+	err := c.cc.Invoke(ctx, VMWareDesktopAutoscalerExtensionService_GetVmnet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMWareDesktopAutoscalerExtensionServiceClient) CreateVmnet(ctx context.Context, in *Vmnet, opts ...grpc.CallOption) (*VmnetResponse, error) {
+	out := new(VmnetResponse)
+	err := c.cc.Invoke(ctx, VMWareDesktopAutoscalerExtensionService_CreateVmnet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMWareDesktopAutoscalerExtensionServiceClient) UpdateVmnet(ctx context.Context, in *Vmnet, opts ...grpc.CallOption) (*VmnetResponse, error) {
+	out := new(VmnetResponse)
+	err := c.cc.Invoke(ctx, VMWareDesktopAutoscalerExtensionService_UpdateVmnet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMWareDesktopAutoscalerExtensionServiceClient) DeleteVmnet(ctx context.Context, in *VmnetRequest, opts ...grpc.CallOption) (*DoneResponse, error) {
+	out := new(DoneResponse)
+	err := c.cc.Invoke(ctx, VMWareDesktopAutoscalerExtensionService_DeleteVmnet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMWareDesktopAutoscalerExtensionServiceClient) ListPortForwards(ctx context.Context, in *VirtualMachineRequest, opts ...grpc.CallOption) (*ListPortForwardsResponse, error) {
+	out := new(ListPortForwardsResponse)
+	err := c.cc.Invoke(ctx, VMWareDesktopAutoscalerExtensionService_ListPortForwards_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMWareDesktopAutoscalerExtensionServiceClient) AddPortForward(ctx context.Context, in *AddPortForwardRequest, opts ...grpc.CallOption) (*PortForwardResponse, error) {
+	out := new(PortForwardResponse)
+	err := c.cc.Invoke(ctx, VMWareDesktopAutoscalerExtensionService_AddPortForward_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMWareDesktopAutoscalerExtensionServiceClient) DeletePortForward(ctx context.Context, in *DeletePortForwardRequest, opts ...grpc.CallOption) (*DoneResponse, error) {
+	out := new(DoneResponse)
+	err := c.cc.Invoke(ctx, VMWareDesktopAutoscalerExtensionService_DeletePortForward_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMWareDesktopAutoscalerExtensionServiceClient) ListIPAllocations(ctx context.Context, in *IPAllocationsRequest, opts ...grpc.CallOption) (*IPAllocationsResponse, error) {
+	out := new(IPAllocationsResponse)
+	err := c.cc.Invoke(ctx, VMWareDesktopAutoscalerExtensionService_ListIPAllocations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMWareDesktopAutoscalerExtensionServiceClient) AllocateIP(ctx context.Context, in *IPAllocation, opts ...grpc.CallOption) (*IPAllocationResponse, error) {
+	out := new(IPAllocationResponse)
+	err := c.cc.Invoke(ctx, VMWareDesktopAutoscalerExtensionService_AllocateIP_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMWareDesktopAutoscalerExtensionServiceClient) ReleaseIP(ctx context.Context, in *IPAllocation, opts ...grpc.CallOption) (*DoneResponse, error) {
+	out := new(DoneResponse)
+	err := c.cc.Invoke(ctx, VMWareDesktopAutoscalerExtensionService_ReleaseIP_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMWareDesktopAutoscalerExtensionServiceClient) WaitForIP(ctx context.Context, in *WaitForIPRequest, opts ...grpc.CallOption) (*WaitForIPResponse, error) {
+	out := new(WaitForIPResponse)
+	err := c.cc.Invoke(ctx, VMWareDesktopAutoscalerExtensionService_WaitForIP_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMWareDesktopAutoscalerExtensionServiceClient) RestoreVMX(ctx context.Context, in *RestoreVMXRequest, opts ...grpc.CallOption) (*VirtualMachineResponse, error) {
+	out := new(VirtualMachineResponse)
+	err := c.cc.Invoke(ctx, VMWareDesktopAutoscalerExtensionService_RestoreVMX_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vMWareDesktopAutoscalerExtensionServiceClient) PreviewCreate(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*PreviewCreateResponse, error) {
+	out := new(PreviewCreateResponse)
+	err := c.cc.Invoke(ctx, VMWareDesktopAutoscalerExtensionService_PreviewCreate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// VMWareDesktopAutoscalerExtensionServiceServer is the server API for VMWareDesktopAutoscalerExtensionService service.
+// All implementations must embed UnimplementedVMWareDesktopAutoscalerExtensionServiceServer
+// for forward compatibility
+type VMWareDesktopAutoscalerExtensionServiceServer interface {
+	ListSnapshots(context.Context, *VirtualMachineRequest) (*ListSnapshotsResponse, error)
+	TakeSnapshot(context.Context, *TakeSnapshotRequest) (*TakeSnapshotResponse, error)
+	RevertToSnapshot(context.Context, *SnapshotRequest) (*DoneResponse, error)
+	DeleteSnapshot(context.Context, *DeleteSnapshotRequest) (*DoneResponse, error)
+	Suspend(context.Context, *PowerModeRequest) (*DoneResponse, error)
+	Pause(context.Context, *VirtualMachineRequest) (*DoneResponse, error)
+	Unpause(context.Context, *VirtualMachineRequest) (*DoneResponse, error)
+	Reset(context.Context, *PowerModeRequest) (*DoneResponse, error)
+	ListPools(context.Context, *PoolsRequest) (*PoolsResponse, error)
+	Reconfigure(context.Context, *ReconfigureRequest) (*VirtualMachineResponse, error)
+	ListDisks(context.Context, *VirtualMachineRequest) (*ListDisksResponse, error)
+	AddDisk(context.Context, *AddDiskRequest) (*AddDiskResponse, error)
+	RemoveDisk(context.Context, *RemoveDiskRequest) (*DoneResponse, error)
+	RunProgramInGuest(context.Context, *RunProgramInGuestRequest) (*ExitCodeResponse, error)
+	RunScriptInGuest(context.Context, *RunScriptInGuestRequest) (*ExitCodeResponse, error)
+	CopyFileToGuest(context.Context, *CopyFileToGuestRequest) (*DoneResponse, error)
+	CopyFileFromGuest(context.Context, *CopyFileFromGuestRequest) (*CopyFileFromGuestResponse, error)
+	ListProcessesInGuest(context.Context, *GuestRequest) (*ListProcessesInGuestResponse, error)
+	ListGuestInfos(context.Context, *VirtualMachineRequest) (*ListGuestInfosResponse, error)
+	GetGuestInfo(context.Context, *GuestInfoRequest) (*GuestInfoResponse, error)
+	SetGuestInfo(context.Context, *SetGuestInfoRequest) (*DoneResponse, error)
+	WatchEvents(*EventsRequest, VMWareDesktopAutoscalerExtensionService_WatchEventsServer) error
+	RemoveNetworkInterface(context.Context, *NetworkInterfaceRequest) (*DoneResponse, error)
+	ListVmnets(context.Context, *VmnetsRequest) (*VmnetsResponse, error)
+	GetVmnet(context.Context, *VmnetRequest) (*VmnetResponse, error)
+	CreateVmnet(context.Context, *Vmnet) (*VmnetResponse, error)
+	UpdateVmnet(context.Context, *Vmnet) (*VmnetResponse, error)
+	DeleteVmnet(context.Context, *VmnetRequest) (*DoneResponse, error)
+	ListPortForwards(context.Context, *VirtualMachineRequest) (*ListPortForwardsResponse, error)
+	AddPortForward(context.Context, *AddPortForwardRequest) (*PortForwardResponse, error)
+	DeletePortForward(context.Context, *DeletePortForwardRequest) (*DoneResponse, error)
+	ListIPAllocations(context.Context, *IPAllocationsRequest) (*IPAllocationsResponse, error)
+	AllocateIP(context.Context, *IPAllocation) (*IPAllocationResponse, error)
+	ReleaseIP(context.Context, *IPAllocation) (*DoneResponse, error)
+	WaitForIP(context.Context, *WaitForIPRequest) (*WaitForIPResponse, error)
+	RestoreVMX(context.Context, *RestoreVMXRequest) (*VirtualMachineResponse, error)
+	PreviewCreate(context.Context, *CreateRequest) (*PreviewCreateResponse, error)
+	mustEmbedUnimplementedVMWareDesktopAutoscalerExtensionServiceServer()
+}
+
+// UnimplementedVMWareDesktopAutoscalerExtensionServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedVMWareDesktopAutoscalerExtensionServiceServer struct {
+}
+
+func (UnimplementedVMWareDesktopAutoscalerExtensionServiceServer) ListSnapshots(context.Context, *VirtualMachineRequest) (*ListSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSnapshots not implemented")
+}
+func (UnimplementedVMWareDesktopAutoscalerExtensionServiceServer) TakeSnapshot(context.Context, *TakeSnapshotRequest) (*TakeSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TakeSnapshot not implemented")
+}
+func (UnimplementedVMWareDesktopAutoscalerExtensionServiceServer) RevertToSnapshot(context.Context, *SnapshotRequest) (*DoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertToSnapshot not implemented")
+}
+func (UnimplementedVMWareDesktopAutoscalerExtensionServiceServer) DeleteSnapshot(context.Context, *DeleteSnapshotRequest) (*DoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSnapshot not implemented")
+}
+func (UnimplementedVMWareDesktopAutoscalerExtensionServiceServer) Suspend(context.Context, *PowerModeRequest) (*DoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Suspend not implemented")
+}
+func (UnimplementedVMWareDesktopAutoscalerExtensionServiceServer) Pause(context.Context, *VirtualMachineRequest) (*DoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pause not implemented")
+}
+func (UnimplementedVMWareDesktopAutoscalerExtensionServiceServer) Unpause(context.Context, *VirtualMachineRequest) (*DoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unpause not implemented")
+}
+func (UnimplementedVMWareDesktopAutoscalerExtensionServiceServer) Reset(context.Context, *PowerModeRequest) (*DoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reset not implemented")
+}
+func (UnimplementedVMWareDesktopAutoscalerExtensionServiceServer) ListPools(context.Context, *PoolsRequest) (*PoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPools not implemented")
+}
+func (UnimplementedVMWareDesktopAutoscalerExtensionServiceServer) Reconfigure(context.Context, *ReconfigureRequest) (*VirtualMachineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reconfigure not implemented")
+}
+func (UnimplementedVMWareDesktopAutoscalerExtensionServiceServer) ListDisks(context.Context, *VirtualMachineRequest) (*ListDisksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDisks not implemented")
+}
+func (UnimplementedVMWareDesktopAutoscalerExtensionServiceServer) AddDisk(context.Context, *AddDiskRequest) (*AddDiskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddDisk not implemented")
+}
+func (UnimplementedVMWareDesktopAutoscalerExtensionServiceServer) RemoveDisk(context.Context, *RemoveDiskRequest) (*DoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveDisk not implemented")
+}
+func (UnimplementedVMWareDesktopAutoscalerExtensionServiceServer) RunProgramInGuest(context.Context, *RunProgramInGuestRequest) (*ExitCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunProgramInGuest not implemented")
+}
+func (UnimplementedVMWareDesktopAutoscalerExtensionServiceServer) RunScriptInGuest(context.Context, *RunScriptInGuestRequest) (*ExitCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunScriptInGuest not implemented")
+}
+func (UnimplementedVMWareDesktopAutoscalerExtensionServiceServer) CopyFileToGuest(context.Context, *CopyFileToGuestRequest) (*DoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CopyFileToGuest not implemented")
+}
+func (UnimplementedVMWareDesktopAutoscalerExtensionServiceServer) CopyFileFromGuest(context.Context, *CopyFileFromGuestRequest) (*CopyFileFromGuestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CopyFileFromGuest not implemented")
+}
+func (UnimplementedVMWareDesktopAutoscalerExtensionServiceServer) ListProcessesInGuest(context.Context, *GuestRequest) (*ListProcessesInGuestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProcessesInGuest not implemented")
+}
+func (UnimplementedVMWareDesktopAutoscalerExtensionServiceServer) ListGuestInfos(context.Context, *VirtualMachineRequest) (*ListGuestInfosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGuestInfos not implemented")
+}
+func (UnimplementedVMWareDesktopAutoscalerExtensionServiceServer) GetGuestInfo(context.Context, *GuestInfoRequest) (*GuestInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGuestInfo not implemented")
+}
+func (UnimplementedVMWareDesktopAutoscalerExtensionServiceServer) SetGuestInfo(context.Context, *SetGuestInfoRequest) (*DoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGuestInfo not implemented")
+}
+func (UnimplementedVMWareDesktopAutoscalerExtensionServiceServer) WatchEvents(*EventsRequest, VMWareDesktopAutoscalerExtensionService_WatchEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchEvents not implemented")
+}
+func (UnimplementedVMWareDesktopAutoscalerExtensionServiceServer) RemoveNetworkInterface(context.Context, *NetworkInterfaceRequest) (*DoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveNetworkInterface not implemented")
+}
+func (UnimplementedVMWareDesktopAutoscalerExtensionServiceServer) ListVmnets(context.Context, *VmnetsRequest) (*VmnetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVmnets not implemented")
+}
+func (UnimplementedVMWareDesktopAutoscalerExtensionServiceServer) GetVmnet(context.Context, *VmnetRequest) (*VmnetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVmnet not implemented")
+}
+func (UnimplementedVMWareDesktopAutoscalerExtensionServiceServer) CreateVmnet(context.Context, *Vmnet) (*VmnetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVmnet not implemented")
+}
+func (UnimplementedVMWareDesktopAutoscalerExtensionServiceServer) UpdateVmnet(context.Context, *Vmnet) (*VmnetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVmnet not implemented")
+}
+func (UnimplementedVMWareDesktopAutoscalerExtensionServiceServer) DeleteVmnet(context.Context, *VmnetRequest) (*DoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVmnet not implemented")
+}
+func (UnimplementedVMWareDesktopAutoscalerExtensionServiceServer) ListPortForwards(context.Context, *VirtualMachineRequest) (*ListPortForwardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPortForwards not implemented")
+}
+func (UnimplementedVMWareDesktopAutoscalerExtensionServiceServer) AddPortForward(context.Context, *AddPortForwardRequest) (*PortForwardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPortForward not implemented")
+}
+func (UnimplementedVMWareDesktopAutoscalerExtensionServiceServer) DeletePortForward(context.Context, *DeletePortForwardRequest) (*DoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePortForward not implemented")
+}
+func (UnimplementedVMWareDesktopAutoscalerExtensionServiceServer) ListIPAllocations(context.Context, *IPAllocationsRequest) (*IPAllocationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIPAllocations not implemented")
+}
+func (UnimplementedVMWareDesktopAutoscalerExtensionServiceServer) AllocateIP(context.Context, *IPAllocation) (*IPAllocationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllocateIP not implemented")
+}
+func (UnimplementedVMWareDesktopAutoscalerExtensionServiceServer) ReleaseIP(context.Context, *IPAllocation) (*DoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseIP not implemented")
+}
+func (UnimplementedVMWareDesktopAutoscalerExtensionServiceServer) WaitForIP(context.Context, *WaitForIPRequest) (*WaitForIPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WaitForIP not implemented")
+}
+func (UnimplementedVMWareDesktopAutoscalerExtensionServiceServer) RestoreVMX(context.Context, *RestoreVMXRequest) (*VirtualMachineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreVMX not implemented")
+}
+func (UnimplementedVMWareDesktopAutoscalerExtensionServiceServer) PreviewCreate(context.Context, *CreateRequest) (*PreviewCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewCreate not implemented")
+}
+func (UnimplementedVMWareDesktopAutoscalerExtensionServiceServer) mustEmbedUnimplementedVMWareDesktopAutoscalerExtensionServiceServer() {
+}
+
+// UnsafeVMWareDesktopAutoscalerExtensionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to VMWareDesktopAutoscalerExtensionServiceServer will
+// result in compilation errors.
+type UnsafeVMWareDesktopAutoscalerExtensionServiceServer interface {
+	mustEmbedUnimplementedVMWareDesktopAutoscalerExtensionServiceServer()
+}
+
+func RegisterVMWareDesktopAutoscalerExtensionServiceServer(s grpc.ServiceRegistrar, srv VMWareDesktopAutoscalerExtensionServiceServer) {
+	s.RegisterService(&VMWareDesktopAutoscalerExtensionService_ServiceDesc, srv)
+}
+
+func _VMWareDesktopAutoscalerExtensionService_ListSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VirtualMachineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).ListSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMWareDesktopAutoscalerExtensionService_ListSnapshots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).ListSnapshots(ctx, req.(*VirtualMachineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMWareDesktopAutoscalerExtensionService_TakeSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TakeSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).TakeSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMWareDesktopAutoscalerExtensionService_TakeSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).TakeSnapshot(ctx, req.(*TakeSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMWareDesktopAutoscalerExtensionService_RevertToSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).RevertToSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMWareDesktopAutoscalerExtensionService_RevertToSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).RevertToSnapshot(ctx, req.(*SnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMWareDesktopAutoscalerExtensionService_DeleteSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).DeleteSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMWareDesktopAutoscalerExtensionService_DeleteSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).DeleteSnapshot(ctx, req.(*DeleteSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMWareDesktopAutoscalerExtensionService_Suspend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PowerModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).Suspend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMWareDesktopAutoscalerExtensionService_Suspend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).Suspend(ctx, req.(*PowerModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMWareDesktopAutoscalerExtensionService_Pause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VirtualMachineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).Pause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMWareDesktopAutoscalerExtensionService_Pause_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).Pause(ctx, req.(*VirtualMachineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMWareDesktopAutoscalerExtensionService_Unpause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VirtualMachineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).Unpause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMWareDesktopAutoscalerExtensionService_Unpause_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).Unpause(ctx, req.(*VirtualMachineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMWareDesktopAutoscalerExtensionService_Reset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PowerModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).Reset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMWareDesktopAutoscalerExtensionService_Reset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).Reset(ctx, req.(*PowerModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMWareDesktopAutoscalerExtensionService_ListPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).ListPools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMWareDesktopAutoscalerExtensionService_ListPools_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).ListPools(ctx, req.(*PoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMWareDesktopAutoscalerExtensionService_Reconfigure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconfigureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).Reconfigure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMWareDesktopAutoscalerExtensionService_Reconfigure_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).Reconfigure(ctx, req.(*ReconfigureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMWareDesktopAutoscalerExtensionService_ListDisks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VirtualMachineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).ListDisks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMWareDesktopAutoscalerExtensionService_ListDisks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).ListDisks(ctx, req.(*VirtualMachineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMWareDesktopAutoscalerExtensionService_AddDisk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddDiskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).AddDisk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMWareDesktopAutoscalerExtensionService_AddDisk_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).AddDisk(ctx, req.(*AddDiskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMWareDesktopAutoscalerExtensionService_RemoveDisk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveDiskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).RemoveDisk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMWareDesktopAutoscalerExtensionService_RemoveDisk_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).RemoveDisk(ctx, req.(*RemoveDiskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMWareDesktopAutoscalerExtensionService_RunProgramInGuest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunProgramInGuestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).RunProgramInGuest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMWareDesktopAutoscalerExtensionService_RunProgramInGuest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).RunProgramInGuest(ctx, req.(*RunProgramInGuestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMWareDesktopAutoscalerExtensionService_RunScriptInGuest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunScriptInGuestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).RunScriptInGuest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMWareDesktopAutoscalerExtensionService_RunScriptInGuest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).RunScriptInGuest(ctx, req.(*RunScriptInGuestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMWareDesktopAutoscalerExtensionService_CopyFileToGuest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CopyFileToGuestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).CopyFileToGuest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMWareDesktopAutoscalerExtensionService_CopyFileToGuest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).CopyFileToGuest(ctx, req.(*CopyFileToGuestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMWareDesktopAutoscalerExtensionService_CopyFileFromGuest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CopyFileFromGuestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).CopyFileFromGuest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMWareDesktopAutoscalerExtensionService_CopyFileFromGuest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).CopyFileFromGuest(ctx, req.(*CopyFileFromGuestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMWareDesktopAutoscalerExtensionService_ListProcessesInGuest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).ListProcessesInGuest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMWareDesktopAutoscalerExtensionService_ListProcessesInGuest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).ListProcessesInGuest(ctx, req.(*GuestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMWareDesktopAutoscalerExtensionService_ListGuestInfos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VirtualMachineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).ListGuestInfos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMWareDesktopAutoscalerExtensionService_ListGuestInfos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).ListGuestInfos(ctx, req.(*VirtualMachineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMWareDesktopAutoscalerExtensionService_GetGuestInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuestInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).GetGuestInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMWareDesktopAutoscalerExtensionService_GetGuestInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).GetGuestInfo(ctx, req.(*GuestInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMWareDesktopAutoscalerExtensionService_SetGuestInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGuestInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).SetGuestInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMWareDesktopAutoscalerExtensionService_SetGuestInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).SetGuestInfo(ctx, req.(*SetGuestInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMWareDesktopAutoscalerExtensionService_WatchEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VMWareDesktopAutoscalerExtensionServiceServer).WatchEvents(m, &vMWareDesktopAutoscalerExtensionServiceWatchEventsServer{stream})
+}
+
+type VMWareDesktopAutoscalerExtensionService_WatchEventsServer interface {
+	Send(*Event) error
+	grpc.ServerStream
+}
+
+type vMWareDesktopAutoscalerExtensionServiceWatchEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *vMWareDesktopAutoscalerExtensionServiceWatchEventsServer) Send(m *Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _VMWareDesktopAutoscalerExtensionService_RemoveNetworkInterface_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NetworkInterfaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).RemoveNetworkInterface(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMWareDesktopAutoscalerExtensionService_RemoveNetworkInterface_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).RemoveNetworkInterface(ctx, req.(*NetworkInterfaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMWareDesktopAutoscalerExtensionService_ListVmnets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VmnetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).ListVmnets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMWareDesktopAutoscalerExtensionService_ListVmnets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).ListVmnets(ctx, req.(*VmnetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMWareDesktopAutoscalerExtensionService_GetVmnet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VmnetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).GetVmnet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMWareDesktopAutoscalerExtensionService_GetVmnet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).GetVmnet(ctx, req.(*VmnetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMWareDesktopAutoscalerExtensionService_CreateVmnet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Vmnet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).CreateVmnet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMWareDesktopAutoscalerExtensionService_CreateVmnet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).CreateVmnet(ctx, req.(*Vmnet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMWareDesktopAutoscalerExtensionService_UpdateVmnet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Vmnet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).UpdateVmnet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMWareDesktopAutoscalerExtensionService_UpdateVmnet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).UpdateVmnet(ctx, req.(*Vmnet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMWareDesktopAutoscalerExtensionService_DeleteVmnet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VmnetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).DeleteVmnet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMWareDesktopAutoscalerExtensionService_DeleteVmnet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).DeleteVmnet(ctx, req.(*VmnetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMWareDesktopAutoscalerExtensionService_ListPortForwards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VirtualMachineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).ListPortForwards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMWareDesktopAutoscalerExtensionService_ListPortForwards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).ListPortForwards(ctx, req.(*VirtualMachineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMWareDesktopAutoscalerExtensionService_AddPortForward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPortForwardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).AddPortForward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMWareDesktopAutoscalerExtensionService_AddPortForward_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).AddPortForward(ctx, req.(*AddPortForwardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMWareDesktopAutoscalerExtensionService_DeletePortForward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePortForwardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).DeletePortForward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMWareDesktopAutoscalerExtensionService_DeletePortForward_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).DeletePortForward(ctx, req.(*DeletePortForwardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMWareDesktopAutoscalerExtensionService_ListIPAllocations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IPAllocationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).ListIPAllocations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMWareDesktopAutoscalerExtensionService_ListIPAllocations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).ListIPAllocations(ctx, req.(*IPAllocationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMWareDesktopAutoscalerExtensionService_AllocateIP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IPAllocation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).AllocateIP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMWareDesktopAutoscalerExtensionService_AllocateIP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).AllocateIP(ctx, req.(*IPAllocation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMWareDesktopAutoscalerExtensionService_ReleaseIP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IPAllocation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).ReleaseIP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMWareDesktopAutoscalerExtensionService_ReleaseIP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).ReleaseIP(ctx, req.(*IPAllocation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMWareDesktopAutoscalerExtensionService_WaitForIP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaitForIPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).WaitForIP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMWareDesktopAutoscalerExtensionService_WaitForIP_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).WaitForIP(ctx, req.(*WaitForIPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMWareDesktopAutoscalerExtensionService_RestoreVMX_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreVMXRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).RestoreVMX(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMWareDesktopAutoscalerExtensionService_RestoreVMX_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).RestoreVMX(ctx, req.(*RestoreVMXRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VMWareDesktopAutoscalerExtensionService_PreviewCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).PreviewCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VMWareDesktopAutoscalerExtensionService_PreviewCreate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VMWareDesktopAutoscalerExtensionServiceServer).PreviewCreate(ctx, req.(*CreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// VMWareDesktopAutoscalerExtensionService_ServiceDesc is the grpc.ServiceDesc for VMWareDesktopAutoscalerExtensionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var VMWareDesktopAutoscalerExtensionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "extension.VMWareDesktopAutoscalerExtensionService",
+	HandlerType: (*VMWareDesktopAutoscalerExtensionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListSnapshots",
+			Handler:    _VMWareDesktopAutoscalerExtensionService_ListSnapshots_Handler,
+		},
+		{
+			MethodName: "TakeSnapshot",
+			Handler:    _VMWareDesktopAutoscalerExtensionService_TakeSnapshot_Handler,
+		},
+		{
+			MethodName: "RevertToSnapshot",
+			Handler:    _VMWareDesktopAutoscalerExtensionService_RevertToSnapshot_Handler,
+		},
+		{
+			MethodName: "DeleteSnapshot",
+			Handler:    _VMWareDesktopAutoscalerExtensionService_DeleteSnapshot_Handler,
+		},
+		{
+			MethodName: "Suspend",
+			Handler:    _VMWareDesktopAutoscalerExtensionService_Suspend_Handler,
+		},
+		{
+			MethodName: "Pause",
+			Handler:    _VMWareDesktopAutoscalerExtensionService_Pause_Handler,
+		},
+		{
+			MethodName: "Unpause",
+			Handler:    _VMWareDesktopAutoscalerExtensionService_Unpause_Handler,
+		},
+		{
+			MethodName: "Reset",
+			Handler:    _VMWareDesktopAutoscalerExtensionService_Reset_Handler,
+		},
+		{
+			MethodName: "ListPools",
+			Handler:    _VMWareDesktopAutoscalerExtensionService_ListPools_Handler,
+		},
+		{
+			MethodName: "Reconfigure",
+			Handler:    _VMWareDesktopAutoscalerExtensionService_Reconfigure_Handler,
+		},
+		{
+			MethodName: "ListDisks",
+			Handler:    _VMWareDesktopAutoscalerExtensionService_ListDisks_Handler,
+		},
+		{
+			MethodName: "AddDisk",
+			Handler:    _VMWareDesktopAutoscalerExtensionService_AddDisk_Handler,
+		},
+		{
+			MethodName: "RemoveDisk",
+			Handler:    _VMWareDesktopAutoscalerExtensionService_RemoveDisk_Handler,
+		},
+		{
+			MethodName: "RunProgramInGuest",
+			Handler:    _VMWareDesktopAutoscalerExtensionService_RunProgramInGuest_Handler,
+		},
+		{
+			MethodName: "RunScriptInGuest",
+			Handler:    _VMWareDesktopAutoscalerExtensionService_RunScriptInGuest_Handler,
+		},
+		{
+			MethodName: "CopyFileToGuest",
+			Handler:    _VMWareDesktopAutoscalerExtensionService_CopyFileToGuest_Handler,
+		},
+		{
+			MethodName: "CopyFileFromGuest",
+			Handler:    _VMWareDesktopAutoscalerExtensionService_CopyFileFromGuest_Handler,
+		},
+		{
+			MethodName: "ListProcessesInGuest",
+			Handler:    _VMWareDesktopAutoscalerExtensionService_ListProcessesInGuest_Handler,
+		},
+		{
+			MethodName: "ListGuestInfos",
+			Handler:    _VMWareDesktopAutoscalerExtensionService_ListGuestInfos_Handler,
+		},
+		{
+			MethodName: "GetGuestInfo",
+			Handler:    _VMWareDesktopAutoscalerExtensionService_GetGuestInfo_Handler,
+		},
+		{
+			MethodName: "SetGuestInfo",
+			Handler:    _VMWareDesktopAutoscalerExtensionService_SetGuestInfo_Handler,
+		},
+		{
+			MethodName: "RemoveNetworkInterface",
+			Handler:    _VMWareDesktopAutoscalerExtensionService_RemoveNetworkInterface_Handler,
+		},
+		{
+			MethodName: "ListVmnets",
+			Handler:    _VMWareDesktopAutoscalerExtensionService_ListVmnets_Handler,
+		},
+		{
+			MethodName: "GetVmnet",
+			Handler:    _VMWareDesktopAutoscalerExtensionService_GetVmnet_Handler,
+		},
+		{
+			MethodName: "CreateVmnet",
+			Handler:    _VMWareDesktopAutoscalerExtensionService_CreateVmnet_Handler,
+		},
+		{
+			MethodName: "UpdateVmnet",
+			Handler:    _VMWareDesktopAutoscalerExtensionService_UpdateVmnet_Handler,
+		},
+		{
+			MethodName: "DeleteVmnet",
+			Handler:    _VMWareDesktopAutoscalerExtensionService_DeleteVmnet_Handler,
+		},
+		{
+			MethodName: "ListPortForwards",
+			Handler:    _VMWareDesktopAutoscalerExtensionService_ListPortForwards_Handler,
+		},
+		{
+			MethodName: "AddPortForward",
+			Handler:    _VMWareDesktopAutoscalerExtensionService_AddPortForward_Handler,
+		},
+		{
+			MethodName: "DeletePortForward",
+			Handler:    _VMWareDesktopAutoscalerExtensionService_DeletePortForward_Handler,
+		},
+		{
+			MethodName: "ListIPAllocations",
+			Handler:    _VMWareDesktopAutoscalerExtensionService_ListIPAllocations_Handler,
+		},
+		{
+			MethodName: "AllocateIP",
+			Handler:    _VMWareDesktopAutoscalerExtensionService_AllocateIP_Handler,
+		},
+		{
+			MethodName: "ReleaseIP",
+			Handler:    _VMWareDesktopAutoscalerExtensionService_ReleaseIP_Handler,
+		},
+		{
+			MethodName: "WaitForIP",
+			Handler:    _VMWareDesktopAutoscalerExtensionService_WaitForIP_Handler,
+		},
+		{
+			MethodName: "RestoreVMX",
+			Handler:    _VMWareDesktopAutoscalerExtensionService_RestoreVMX_Handler,
+		},
+		{
+			MethodName: "PreviewCreate",
+			Handler:    _VMWareDesktopAutoscalerExtensionService_PreviewCreate_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchEvents",
+			Handler:       _VMWareDesktopAutoscalerExtensionService_WatchEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "extension.proto",
+}
@@ -1,3 +1,5 @@
 #!/bin/bash
+set -e
 
-exit 0
\ No newline at end of file
+# Tests needing VMware desktop are skipped unless VMFOLDER is defined
+go test ./...
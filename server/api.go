@@ -22,6 +22,7 @@ type Api struct {
 	router     *RegexpHandler
 	inflight   int
 	stopChan   chan bool
+	closing    chan struct{}
 	reqTracker sync.WaitGroup
 	actionSync sync.Mutex
 	Halted     bool
@@ -56,11 +57,16 @@ func (a *Api) defineRoutes(r *RegexpHandler) error {
 	routes := map[string]func(http.ResponseWriter, *http.Request){
 		`/api/(?P<path>.+)`:                                      r.handleVmrestProxy,
 		`/vm/create`:                                             r.handleCreateVirtualMachine,
+		`/vm/create/preview`:                                     r.handlePreviewCreateVirtualMachine,
 		`/vm/delete/(?P<vmuuid>.+)`:                              r.handleDeleteVirtualMachine,
 		`/vm/poweron/(?P<vmuuid>.+)`:                             r.handlePowerOnVirtualMachine,
 		`/vm/poweroff/(?P<vmuuid>.+)`:                            r.handlePowerOffVirtualMachine,
 		`/vm/powerstate/(?P<vmuuid>.+)`:                          r.handlePowerStateVirtualMachine,
 		`/vm/shutdownguest/(?P<vmuuid>.+)`:                       r.handleShutdownGuestVirtualMachine,
+		`/vm/suspend/(?P<vmuuid>.+)`:                             r.handleSuspendVirtualMachine,
+		`/vm/pause/(?P<vmuuid>.+)`:                               r.handlePauseVirtualMachine,
+		`/vm/unpause/(?P<vmuuid>.+)`:                             r.handleUnpauseVirtualMachine,
+		`/vm/reset/(?P<vmuuid>.+)`:                               r.handleResetVirtualMachine,
 		`/vm/waitforip/(?P<vmuuid>.+)`:                           r.handleWaitForIP,
 		`/vm/waitfortoolsrunning/(?P<vmuuid>.+)`:                 r.handleWaitForToolsRunning,
 		`/vm/autostart/(?P<vmuuid>.+)/(?P<autostart>true|false)`: r.handleSetAutoStart,
@@ -68,7 +74,25 @@ func (a *Api) defineRoutes(r *RegexpHandler) error {
 		`/vm/byname/(?P<name>.+)`:                                r.handleVirtualMachineByName,
 		`/vm/byuuid/(?P<vmuuid>.+)`:                              r.handleVirtualMachineByUUID,
 		`/vms`:                                                   r.handleListVirtualMachines,
-		`/vm/nic/(?P<vmuuid>.+)`:                                 r.handleNetworkInterface,
+		`/vmnet`:                                                 r.handleVmnets,
+		`/vmnet/(?P<name>[^/]+)`:                                 r.handleVmnet,
+		`/ipam`:                                                  r.handleIPAllocations,
+		`/ipam/(?P<vmnet>[^/]+)`:                                 r.handleIPAllocations,
+		`/events`:                                                r.handleEvents,
+		`/vm/pools`:                                              r.handleListPools,
+		`/vm/nic/(?P<vmuuid>[^/]+)`:                              r.handleNetworkInterface,
+		`/vm/nic/(?P<vmuuid>[^/]+)/(?P<index>[0-9]+)`:            r.handleRemoveNetworkInterface,
+		`/vm/portforward/(?P<vmuuid>[^/]+)`:                      r.handlePortForwards,
+		`/vm/snapshot/(?P<vmuuid>.+)`:                            r.handleSnapshot,
+		`/vm/disk/(?P<vmuuid>.+)`:                                r.handleDisk,
+		`/vm/restore/(?P<vmuuid>.+)`:                             r.handleRestoreVMX,
+		`/vm/guest/program/(?P<vmuuid>.+)`:                       r.handleGuestProgram,
+		`/vm/guest/script/(?P<vmuuid>.+)`:                        r.handleGuestScript,
+		`/vm/guest/upload/(?P<vmuuid>.+)`:                        r.handleGuestUpload,
+		`/vm/guest/download/(?P<vmuuid>.+)`:                      r.handleGuestDownload,
+		`/vm/guest/processes/(?P<vmuuid>.+)`:                     r.handleGuestProcesses,
+		`/vm/guestinfo/(?P<vmuuid>[^/]+)`:                        r.handleListGuestInfos,
+		`/vm/guestinfo/(?P<vmuuid>[^/]+)/(?P<key>.+)`:            r.handleGuestInfo,
 		`/vmware/paths`:                                          r.handleVmwarePaths,
 		`/vmware/info`:                                           r.handleVmwareInfo,
 		`/status`:                                                r.handleStatus,
@@ -108,6 +132,7 @@ func (a *Api) Start() error {
 	}
 
 	a.router.vmrun.StartAutostartVM()
+	a.router.vmrun.StartPools()
 
 	listener, err := tls.Listen("tcp", fmt.Sprintf("%s:%d", a.Address, a.Port), tlsConfig)
 
@@ -116,6 +141,7 @@ func (a *Api) Start() error {
 	}
 
 	a.listener = listener
+	a.closing = make(chan struct{})
 	a.Halted = false
 
 	go a.consume()
@@ -153,6 +179,8 @@ func (a *Api) consume() {
 	if <-a.stopChan {
 		a.logger.Debug("stop notification received - closing")
 		a.listener.Close()
+		// Release long lived requests like events stream
+		close(a.closing)
 		a.logger.Trace("wait for inflight requests to complete")
 		a.reqTracker.Wait()
 		a.logger.Trace("api consumer halted")
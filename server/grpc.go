@@ -14,6 +14,7 @@ import (
 
 	"github.com/Fred78290/kubernetes-desktop-autoscaler/api"
 	"github.com/Fred78290/vmware-desktop-autoscaler-utility/driver"
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/extension"
 	"github.com/Fred78290/vmware-desktop-autoscaler-utility/service"
 	"github.com/Fred78290/vmware-desktop-autoscaler-utility/status"
 	"github.com/Fred78290/vmware-desktop-autoscaler-utility/utility"
@@ -128,6 +129,8 @@ func (g *Grpc) Start() error {
 	g.server = server
 	g.Halted = false
 
+	g.vmrun.StartPools()
+
 	go g.consume()
 
 	g.Debugf("api ready for message consumption")
@@ -182,6 +185,7 @@ func (g *Grpc) createServer() (*grpc.Server, error) {
 	}
 
 	api.RegisterVMWareDesktopAutoscalerServiceServer(server, g)
+	extension.RegisterVMWareDesktopAutoscalerExtensionServiceServer(server, &grpcExtension{Grpc: g})
 
 	return server, nil
 }
@@ -281,7 +285,7 @@ func (g *Grpc) Create(ctx context.Context, req *api.CreateRequest) (*api.CreateR
 					Vmx:         result.Path,
 					Vcpus:       req.Vcpus,
 					Memory:      req.Memory,
-					Powered:     result.Powered,
+					Powered:     result.PowerState.Powered(),
 					Address:     result.Address,
 					ToolsStatus: result.ToolsStatus,
 				},
@@ -398,7 +402,7 @@ func (g *Grpc) PowerState(ctx context.Context, req *api.VirtualMachineRequest) (
 	} else {
 		return &api.PowerStateResponse{
 			Response: &api.PowerStateResponse_Powered{
-				Powered: result,
+				Powered: result.Powered(),
 			},
 		}, nil
 	}
@@ -474,7 +478,7 @@ func (g *Grpc) Status(ctx context.Context, req *api.VirtualMachineRequest) (*api
 		return &api.StatusResponse{
 			Response: &api.StatusResponse_Result{
 				Result: &api.StatusReply{
-					Powered:  result.Powered,
+					Powered:  result.PowerState.Powered(),
 					Ethernet: networks,
 				},
 			},
@@ -487,7 +491,8 @@ func (g *Grpc) WaitForIP(ctx context.Context, req *api.WaitForIPRequest) (*api.W
 
 	defer g.decrementInflight()
 
-	if address, err := g.vmrun.WaitForIP(req.Identifier, time.Duration(req.TimeoutInSeconds)*time.Second); err != nil {
+	// WaitForIPRequest has no network, the address reported by vmrun is awaited. The extension WaitForIP take the selector.
+	if addresses, err := g.vmrun.WaitForIP(req.Identifier, "", time.Duration(req.TimeoutInSeconds)*time.Second); err != nil {
 		if _, ok := status.FromError(err); ok {
 			return nil, err
 		}
@@ -504,7 +509,7 @@ func (g *Grpc) WaitForIP(ctx context.Context, req *api.WaitForIPRequest) (*api.W
 		return &api.WaitForIPResponse{
 			Response: &api.WaitForIPResponse_Result{
 				Result: &api.WaitForIPReply{
-					Address: address,
+					Address: addresses.Address,
 				},
 			},
 		}, nil
@@ -595,7 +600,7 @@ func (g *Grpc) VirtualMachineByName(ctx context.Context, req *api.VirtualMachine
 					Name:        vm.Name,
 					Vcpus:       int32(vm.Vcpus),
 					Memory:      int64(vm.Memory),
-					Powered:     vm.Powered,
+					Powered:     vm.PowerState.Powered(),
 					Address:     vm.Address,
 					ToolsStatus: vm.ToolsStatus,
 				},
@@ -631,7 +636,7 @@ func (g *Grpc) VirtualMachineByUUID(ctx context.Context, req *api.VirtualMachine
 					Name:        vm.Name,
 					Vcpus:       int32(vm.Vcpus),
 					Memory:      int64(vm.Memory),
-					Powered:     vm.Powered,
+					Powered:     vm.PowerState.Powered(),
 					Address:     vm.Address,
 					ToolsStatus: vm.ToolsStatus,
 				},
@@ -668,7 +673,7 @@ func (g *Grpc) ListVirtualMachines(ctx context.Context, req *api.VirtualMachines
 				Vmx:         vm.Path,
 				Vcpus:       int32(vm.Vcpus),
 				Memory:      int64(vm.Memory),
-				Powered:     vm.Powered,
+				Powered:     vm.PowerState.Powered(),
 				Address:     vm.Address,
 				ToolsStatus: vm.ToolsStatus,
 			})
@@ -0,0 +1,956 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/driver"
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/extension"
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/service"
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/status"
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/utils"
+	vagrant_driver "github.com/hashicorp/vagrant-vmware-desktop/go_src/vagrant-vmware-utility/driver"
+)
+
+// grpcExtension serve the operations not declared by the api.proto of kubernetes-desktop-autoscaler
+type grpcExtension struct {
+	extension.UnimplementedVMWareDesktopAutoscalerExtensionServiceServer
+
+	*Grpc
+}
+
+// extensionError return the status errors as is, the others are reported to the client by a ClientError
+func extensionError(err error) (*extension.ClientError, error) {
+	if _, ok := status.FromError(err); ok {
+		return nil, err
+	}
+
+	return &extension.ClientError{
+		Code:   500,
+		Reason: err.Error(),
+	}, nil
+}
+
+func doneResponse(done bool, err error) (*extension.DoneResponse, error) {
+	if err != nil {
+		if clientError, err := extensionError(err); err != nil {
+			return nil, err
+		} else {
+			return &extension.DoneResponse{
+				Response: &extension.DoneResponse_Error{
+					Error: clientError,
+				},
+			}, nil
+		}
+	}
+
+	return &extension.DoneResponse{
+		Response: &extension.DoneResponse_Result{
+			Result: &extension.DoneReply{
+				Done: done,
+			},
+		},
+	}, nil
+}
+
+func toSnapshots(snapshots []*service.Snapshot) []*extension.Snapshot {
+	result := make([]*extension.Snapshot, 0, len(snapshots))
+
+	for _, snapshot := range snapshots {
+		result = append(result, toSnapshot(snapshot))
+	}
+
+	return result
+}
+
+func toSnapshot(snapshot *service.Snapshot) *extension.Snapshot {
+	return &extension.Snapshot{
+		Name:        snapshot.Name,
+		Description: snapshot.Description,
+		CreateTime:  snapshot.CreateTime.Unix(),
+		Current:     snapshot.Current,
+		Children:    toSnapshots(snapshot.Children),
+	}
+}
+
+func (e *grpcExtension) ListSnapshots(ctx context.Context, req *extension.VirtualMachineRequest) (*extension.ListSnapshotsResponse, error) {
+	e.incrementInflight()
+
+	defer e.decrementInflight()
+
+	if result, err := e.vmrun.ListSnapshots(req.Identifier); err != nil {
+		if clientError, err := extensionError(err); err != nil {
+			return nil, err
+		} else {
+			return &extension.ListSnapshotsResponse{
+				Response: &extension.ListSnapshotsResponse_Error{
+					Error: clientError,
+				},
+			}, nil
+		}
+	} else {
+		return &extension.ListSnapshotsResponse{
+			Response: &extension.ListSnapshotsResponse_Result{
+				Result: &extension.ListSnapshotsReply{
+					Snapshots: toSnapshots(result),
+				},
+			},
+		}, nil
+	}
+}
+
+func (e *grpcExtension) TakeSnapshot(ctx context.Context, req *extension.TakeSnapshotRequest) (*extension.TakeSnapshotResponse, error) {
+	e.incrementInflight()
+
+	defer e.decrementInflight()
+
+	if result, err := e.vmrun.TakeSnapshot(req.Identifier, req.Name, req.Description); err != nil {
+		if clientError, err := extensionError(err); err != nil {
+			return nil, err
+		} else {
+			return &extension.TakeSnapshotResponse{
+				Response: &extension.TakeSnapshotResponse_Error{
+					Error: clientError,
+				},
+			}, nil
+		}
+	} else {
+		return &extension.TakeSnapshotResponse{
+			Response: &extension.TakeSnapshotResponse_Result{
+				Result: toSnapshot(result),
+			},
+		}, nil
+	}
+}
+
+func (e *grpcExtension) RevertToSnapshot(ctx context.Context, req *extension.SnapshotRequest) (*extension.DoneResponse, error) {
+	e.incrementInflight()
+
+	defer e.decrementInflight()
+
+	return doneResponse(e.vmrun.RevertToSnapshot(req.Identifier, req.Name))
+}
+
+func (e *grpcExtension) DeleteSnapshot(ctx context.Context, req *extension.DeleteSnapshotRequest) (*extension.DoneResponse, error) {
+	e.incrementInflight()
+
+	defer e.decrementInflight()
+
+	return doneResponse(e.vmrun.DeleteSnapshot(req.Identifier, req.Name, req.DeleteChildren))
+}
+
+func (e *grpcExtension) Suspend(ctx context.Context, req *extension.PowerModeRequest) (*extension.DoneResponse, error) {
+	e.incrementInflight()
+
+	defer e.decrementInflight()
+
+	return doneResponse(e.vmrun.Suspend(req.Identifier, req.Mode))
+}
+
+func (e *grpcExtension) Pause(ctx context.Context, req *extension.VirtualMachineRequest) (*extension.DoneResponse, error) {
+	e.incrementInflight()
+
+	defer e.decrementInflight()
+
+	return doneResponse(e.vmrun.Pause(req.Identifier))
+}
+
+func (e *grpcExtension) Unpause(ctx context.Context, req *extension.VirtualMachineRequest) (*extension.DoneResponse, error) {
+	e.incrementInflight()
+
+	defer e.decrementInflight()
+
+	return doneResponse(e.vmrun.Unpause(req.Identifier))
+}
+
+func (e *grpcExtension) Reset(ctx context.Context, req *extension.PowerModeRequest) (*extension.DoneResponse, error) {
+	e.incrementInflight()
+
+	defer e.decrementInflight()
+
+	return doneResponse(e.vmrun.Reset(req.Identifier, req.Mode))
+}
+
+func (e *grpcExtension) ListPools(ctx context.Context, req *extension.PoolsRequest) (*extension.PoolsResponse, error) {
+	e.incrementInflight()
+
+	defer e.decrementInflight()
+
+	pools := e.vmrun.ListPools()
+	result := make([]*extension.PoolStatus, 0, len(pools))
+
+	for _, pool := range pools {
+		result = append(result, &extension.PoolStatus{
+			Template: pool.Template,
+			Size:     int32(pool.Size),
+			Ready:    pool.Ready,
+			Filling:  int32(pool.Filling),
+		})
+	}
+
+	return &extension.PoolsResponse{
+		Response: &extension.PoolsResponse_Result{
+			Result: &extension.PoolsReply{
+				Pools: result,
+			},
+		},
+	}, nil
+}
+
+func toVirtualMachine(vm *service.VirtualMachine) *extension.VirtualMachine {
+	return &extension.VirtualMachine{
+		Uuid:        vm.Uuid,
+		Vmx:         vm.Path,
+		Name:        vm.Name,
+		Vcpus:       int32(vm.Vcpus),
+		Memory:      int64(vm.Memory),
+		Powered:     vm.PowerState.Powered(),
+		Address:     vm.Address,
+		ToolsStatus: vm.ToolsStatus,
+	}
+}
+
+func (e *grpcExtension) Reconfigure(ctx context.Context, req *extension.ReconfigureRequest) (*extension.VirtualMachineResponse, error) {
+	e.incrementInflight()
+
+	defer e.decrementInflight()
+
+	spec := &service.ReconfigureVirtualMachine{
+		Vcpus:        int(req.Vcpus),
+		Memory:       int(req.Memory),
+		DiskSizeInMb: int(req.DiskSizeInMb),
+	}
+
+	if vm, err := e.vmrun.Reconfigure(req.Identifier, spec); err != nil {
+		if clientError, err := extensionError(err); err != nil {
+			return nil, err
+		} else {
+			return &extension.VirtualMachineResponse{
+				Response: &extension.VirtualMachineResponse_Error{
+					Error: clientError,
+				},
+			}, nil
+		}
+	} else {
+		return &extension.VirtualMachineResponse{
+			Response: &extension.VirtualMachineResponse_Result{
+				Result: toVirtualMachine(vm),
+			},
+		}, nil
+	}
+}
+
+func toVirtualDisk(disk *service.VirtualDisk) *extension.VirtualDisk {
+	return &extension.VirtualDisk{
+		Slot:       disk.Slot,
+		Controller: disk.Controller,
+		Bus:        int32(disk.Bus),
+		Unit:       int32(disk.Unit),
+		FileName:   disk.FileName,
+		SizeInMb:   int32(disk.SizeInMb),
+	}
+}
+
+func (e *grpcExtension) ListDisks(ctx context.Context, req *extension.VirtualMachineRequest) (*extension.ListDisksResponse, error) {
+	e.incrementInflight()
+
+	defer e.decrementInflight()
+
+	if disks, err := e.vmrun.ListDisks(req.Identifier); err != nil {
+		if clientError, err := extensionError(err); err != nil {
+			return nil, err
+		} else {
+			return &extension.ListDisksResponse{
+				Response: &extension.ListDisksResponse_Error{
+					Error: clientError,
+				},
+			}, nil
+		}
+	} else {
+		result := make([]*extension.VirtualDisk, 0, len(disks))
+
+		for _, disk := range disks {
+			result = append(result, toVirtualDisk(disk))
+		}
+
+		return &extension.ListDisksResponse{
+			Response: &extension.ListDisksResponse_Result{
+				Result: &extension.ListDisksReply{
+					Disks: result,
+				},
+			},
+		}, nil
+	}
+}
+
+func (e *grpcExtension) AddDisk(ctx context.Context, req *extension.AddDiskRequest) (*extension.AddDiskResponse, error) {
+	e.incrementInflight()
+
+	defer e.decrementInflight()
+
+	request := &service.CreateVirtualDisk{
+		Controller: req.Controller,
+		SizeInMb:   int(req.SizeInMb),
+	}
+
+	if disk, err := e.vmrun.AddDisk(req.Identifier, request); err != nil {
+		if clientError, err := extensionError(err); err != nil {
+			return nil, err
+		} else {
+			return &extension.AddDiskResponse{
+				Response: &extension.AddDiskResponse_Error{
+					Error: clientError,
+				},
+			}, nil
+		}
+	} else {
+		return &extension.AddDiskResponse{
+			Response: &extension.AddDiskResponse_Result{
+				Result: toVirtualDisk(disk),
+			},
+		}, nil
+	}
+}
+
+func (e *grpcExtension) RemoveDisk(ctx context.Context, req *extension.RemoveDiskRequest) (*extension.DoneResponse, error) {
+	e.incrementInflight()
+
+	defer e.decrementInflight()
+
+	return doneResponse(e.vmrun.RemoveDisk(req.Identifier, req.Slot, req.DeleteFile))
+}
+
+func toGuestCredentials(creds *extension.GuestCredentials) *service.GuestCredentials {
+	return &service.GuestCredentials{
+		Username: creds.GetUsername(),
+		Password: creds.GetPassword(),
+	}
+}
+
+func exitCodeResponse(exitCode int, err error) (*extension.ExitCodeResponse, error) {
+	if err != nil {
+		if clientError, err := extensionError(err); err != nil {
+			return nil, err
+		} else {
+			return &extension.ExitCodeResponse{
+				Response: &extension.ExitCodeResponse_Error{
+					Error: clientError,
+				},
+			}, nil
+		}
+	}
+
+	return &extension.ExitCodeResponse{
+		Response: &extension.ExitCodeResponse_Result{
+			Result: &extension.ExitCodeReply{
+				ExitCode: int32(exitCode),
+			},
+		},
+	}, nil
+}
+
+func (e *grpcExtension) RunProgramInGuest(ctx context.Context, req *extension.RunProgramInGuestRequest) (*extension.ExitCodeResponse, error) {
+	e.incrementInflight()
+
+	defer e.decrementInflight()
+
+	return exitCodeResponse(e.vmrun.RunProgramInGuest(req.Identifier, toGuestCredentials(req.Credentials), req.Program, req.Arguments, req.NoWait))
+}
+
+func (e *grpcExtension) RunScriptInGuest(ctx context.Context, req *extension.RunScriptInGuestRequest) (*extension.ExitCodeResponse, error) {
+	e.incrementInflight()
+
+	defer e.decrementInflight()
+
+	return exitCodeResponse(e.vmrun.RunScriptInGuest(req.Identifier, toGuestCredentials(req.Credentials), req.Interpreter, req.Script, req.NoWait))
+}
+
+func (e *grpcExtension) CopyFileToGuest(ctx context.Context, req *extension.CopyFileToGuestRequest) (*extension.DoneResponse, error) {
+	e.incrementInflight()
+
+	defer e.decrementInflight()
+
+	return doneResponse(copyContentToGuest(e.vmrun, req.Identifier, toGuestCredentials(req.Credentials), req.Content, req.GuestPath))
+}
+
+func (e *grpcExtension) CopyFileFromGuest(ctx context.Context, req *extension.CopyFileFromGuestRequest) (*extension.CopyFileFromGuestResponse, error) {
+	e.incrementInflight()
+
+	defer e.decrementInflight()
+
+	if content, err := copyContentFromGuest(e.vmrun, req.Identifier, toGuestCredentials(req.Credentials), req.GuestPath); err != nil {
+		if clientError, err := extensionError(err); err != nil {
+			return nil, err
+		} else {
+			return &extension.CopyFileFromGuestResponse{
+				Response: &extension.CopyFileFromGuestResponse_Error{
+					Error: clientError,
+				},
+			}, nil
+		}
+	} else {
+		return &extension.CopyFileFromGuestResponse{
+			Response: &extension.CopyFileFromGuestResponse_Result{
+				Result: &extension.CopyFileFromGuestReply{
+					Content: content,
+				},
+			},
+		}, nil
+	}
+}
+
+func (e *grpcExtension) ListProcessesInGuest(ctx context.Context, req *extension.GuestRequest) (*extension.ListProcessesInGuestResponse, error) {
+	e.incrementInflight()
+
+	defer e.decrementInflight()
+
+	if processes, err := e.vmrun.ListProcessesInGuest(req.Identifier, toGuestCredentials(req.Credentials)); err != nil {
+		if clientError, err := extensionError(err); err != nil {
+			return nil, err
+		} else {
+			return &extension.ListProcessesInGuestResponse{
+				Response: &extension.ListProcessesInGuestResponse_Error{
+					Error: clientError,
+				},
+			}, nil
+		}
+	} else {
+		result := make([]*extension.GuestProcess, 0, len(processes))
+
+		for _, process := range processes {
+			result = append(result, &extension.GuestProcess{
+				Pid:   int32(process.Pid),
+				Owner: process.Owner,
+				Cmd:   process.Command,
+			})
+		}
+
+		return &extension.ListProcessesInGuestResponse{
+			Response: &extension.ListProcessesInGuestResponse_Result{
+				Result: &extension.ListProcessesInGuestReply{
+					Processes: result,
+				},
+			},
+		}, nil
+	}
+}
+
+func (e *grpcExtension) ListGuestInfos(ctx context.Context, req *extension.VirtualMachineRequest) (*extension.ListGuestInfosResponse, error) {
+	e.incrementInflight()
+
+	defer e.decrementInflight()
+
+	if guestinfos, err := e.vmrun.ListGuestInfos(req.Identifier); err != nil {
+		if clientError, err := extensionError(err); err != nil {
+			return nil, err
+		} else {
+			return &extension.ListGuestInfosResponse{
+				Response: &extension.ListGuestInfosResponse_Error{
+					Error: clientError,
+				},
+			}, nil
+		}
+	} else {
+		return &extension.ListGuestInfosResponse{
+			Response: &extension.ListGuestInfosResponse_Result{
+				Result: &extension.ListGuestInfosReply{
+					GuestInfos: guestinfos,
+				},
+			},
+		}, nil
+	}
+}
+
+func (e *grpcExtension) GetGuestInfo(ctx context.Context, req *extension.GuestInfoRequest) (*extension.GuestInfoResponse, error) {
+	e.incrementInflight()
+
+	defer e.decrementInflight()
+
+	if value, err := e.vmrun.GetGuestInfo(req.Identifier, req.Key); err != nil {
+		if clientError, err := extensionError(err); err != nil {
+			return nil, err
+		} else {
+			return &extension.GuestInfoResponse{
+				Response: &extension.GuestInfoResponse_Error{
+					Error: clientError,
+				},
+			}, nil
+		}
+	} else {
+		return &extension.GuestInfoResponse{
+			Response: &extension.GuestInfoResponse_Result{
+				Result: &extension.GuestInfoReply{
+					Value: value,
+				},
+			},
+		}, nil
+	}
+}
+
+func (e *grpcExtension) SetGuestInfo(ctx context.Context, req *extension.SetGuestInfoRequest) (*extension.DoneResponse, error) {
+	e.incrementInflight()
+
+	defer e.decrementInflight()
+
+	return doneResponse(e.vmrun.SetGuestInfo(req.Identifier, req.Key, req.Value))
+}
+
+// WatchEvents stream the VM events until the client leave or the server stop
+func (e *grpcExtension) WatchEvents(req *extension.EventsRequest, stream extension.VMWareDesktopAutoscalerExtensionService_WatchEventsServer) error {
+	e.incrementInflight()
+
+	defer e.decrementInflight()
+
+	events, unsubscribe := e.vmrun.Subscribe()
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case event, ok := <-events:
+			if !ok {
+				return nil
+			}
+
+			if err := stream.Send(&extension.Event{
+				Type:       string(event.Type),
+				Time:       event.Time.Unix(),
+				Uuid:       event.Uuid,
+				Name:       event.Name,
+				PowerState: string(event.PowerState),
+				Address:    event.Address,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (e *grpcExtension) RemoveNetworkInterface(ctx context.Context, req *extension.NetworkInterfaceRequest) (*extension.DoneResponse, error) {
+	e.incrementInflight()
+
+	defer e.decrementInflight()
+
+	if err := e.vmrun.RemoveNetworkInterface(req.Identifier, int(req.Index)); err != nil {
+		return doneResponse(false, err)
+	}
+
+	return doneResponse(true, nil)
+}
+
+func toVmnet(vnet *vagrant_driver.Vmnet) *extension.Vmnet {
+	return &extension.Vmnet{
+		Name:   vnet.Name,
+		Type:   vnet.Type,
+		Dhcp:   vnet.Dhcp,
+		Subnet: vnet.Subnet,
+		Mask:   vnet.Mask,
+	}
+}
+
+func fromVmnet(vnet *extension.Vmnet) *vagrant_driver.Vmnet {
+	return &vagrant_driver.Vmnet{
+		Name:   vnet.Name,
+		Type:   vnet.Type,
+		Dhcp:   vnet.Dhcp,
+		Subnet: vnet.Subnet,
+		Mask:   vnet.Mask,
+	}
+}
+
+func vmnetResponse(vnet *vagrant_driver.Vmnet, err error) (*extension.VmnetResponse, error) {
+	if err != nil {
+		if clientError, err := extensionError(err); err != nil {
+			return nil, err
+		} else {
+			return &extension.VmnetResponse{
+				Response: &extension.VmnetResponse_Error{
+					Error: clientError,
+				},
+			}, nil
+		}
+	}
+
+	return &extension.VmnetResponse{
+		Response: &extension.VmnetResponse_Result{
+			Result: toVmnet(vnet),
+		},
+	}, nil
+}
+
+func (e *grpcExtension) ListVmnets(ctx context.Context, req *extension.VmnetsRequest) (*extension.VmnetsResponse, error) {
+	e.incrementInflight()
+
+	defer e.decrementInflight()
+
+	if vmnets, err := driver.ListVmnets(e.Driver); err != nil {
+		if clientError, err := extensionError(err); err != nil {
+			return nil, err
+		} else {
+			return &extension.VmnetsResponse{
+				Response: &extension.VmnetsResponse_Error{
+					Error: clientError,
+				},
+			}, nil
+		}
+	} else {
+		result := make([]*extension.Vmnet, 0, len(vmnets.Vmnets))
+
+		for _, vnet := range vmnets.Vmnets {
+			result = append(result, toVmnet(vnet))
+		}
+
+		return &extension.VmnetsResponse{
+			Response: &extension.VmnetsResponse_Result{
+				Result: &extension.VmnetsReply{
+					Vmnets: result,
+				},
+			},
+		}, nil
+	}
+}
+
+func (e *grpcExtension) GetVmnet(ctx context.Context, req *extension.VmnetRequest) (*extension.VmnetResponse, error) {
+	e.incrementInflight()
+
+	defer e.decrementInflight()
+
+	return vmnetResponse(driver.VmnetByName(e.Driver, req.Name))
+}
+
+func (e *grpcExtension) CreateVmnet(ctx context.Context, req *extension.Vmnet) (*extension.VmnetResponse, error) {
+	e.incrementInflight()
+
+	defer e.decrementInflight()
+
+	vnet := fromVmnet(req)
+
+	if err := driver.CreateVmnet(e.Driver, vnet); err != nil {
+		return vmnetResponse(nil, err)
+	}
+
+	e.logger.Info("vmnet created", "name", vnet.Name, "type", vnet.Type, "subnet", vnet.Subnet, "mask", vnet.Mask)
+
+	return vmnetResponse(vnet, nil)
+}
+
+func (e *grpcExtension) UpdateVmnet(ctx context.Context, req *extension.Vmnet) (*extension.VmnetResponse, error) {
+	e.incrementInflight()
+
+	defer e.decrementInflight()
+
+	vnet, err := driver.UpdateVmnet(e.Driver, req.Name, fromVmnet(req))
+
+	if err == nil {
+		e.logger.Info("vmnet updated", "name", vnet.Name, "type", vnet.Type, "subnet", vnet.Subnet, "mask", vnet.Mask)
+	}
+
+	return vmnetResponse(vnet, err)
+}
+
+func (e *grpcExtension) DeleteVmnet(ctx context.Context, req *extension.VmnetRequest) (*extension.DoneResponse, error) {
+	e.incrementInflight()
+
+	defer e.decrementInflight()
+
+	if err := driver.DeleteVmnet(e.Driver, req.Name); err != nil {
+		return doneResponse(false, err)
+	}
+
+	e.logger.Info("vmnet deleted", "name", req.Name)
+
+	return doneResponse(true, nil)
+}
+
+func toPortForward(forward *service.PortForward) *extension.PortForward {
+	return &extension.PortForward{
+		Vmnet:       forward.Vmnet,
+		Protocol:    forward.Protocol,
+		HostPort:    int32(forward.HostPort),
+		GuestIp:     forward.GuestIP,
+		GuestPort:   int32(forward.GuestPort),
+		Description: forward.Description,
+	}
+}
+
+func (e *grpcExtension) ListPortForwards(ctx context.Context, req *extension.VirtualMachineRequest) (*extension.ListPortForwardsResponse, error) {
+	e.incrementInflight()
+
+	defer e.decrementInflight()
+
+	if forwards, err := e.vmrun.ListPortForwards(req.Identifier); err != nil {
+		if clientError, err := extensionError(err); err != nil {
+			return nil, err
+		} else {
+			return &extension.ListPortForwardsResponse{
+				Response: &extension.ListPortForwardsResponse_Error{
+					Error: clientError,
+				},
+			}, nil
+		}
+	} else {
+		result := make([]*extension.PortForward, 0, len(forwards))
+
+		for _, forward := range forwards {
+			result = append(result, toPortForward(forward))
+		}
+
+		return &extension.ListPortForwardsResponse{
+			Response: &extension.ListPortForwardsResponse_Result{
+				Result: &extension.ListPortForwardsReply{
+					Forwards: result,
+				},
+			},
+		}, nil
+	}
+}
+
+func (e *grpcExtension) AddPortForward(ctx context.Context, req *extension.AddPortForwardRequest) (*extension.PortForwardResponse, error) {
+	e.incrementInflight()
+
+	defer e.decrementInflight()
+
+	forward := &service.PortForward{
+		Vmnet:       req.Forward.GetVmnet(),
+		Protocol:    req.Forward.GetProtocol(),
+		HostPort:    int(req.Forward.GetHostPort()),
+		GuestIP:     req.Forward.GetGuestIp(),
+		GuestPort:   int(req.Forward.GetGuestPort()),
+		Description: req.Forward.GetDescription(),
+	}
+
+	if added, err := e.vmrun.AddPortForward(req.Identifier, forward); err != nil {
+		if clientError, err := extensionError(err); err != nil {
+			return nil, err
+		} else {
+			return &extension.PortForwardResponse{
+				Response: &extension.PortForwardResponse_Error{
+					Error: clientError,
+				},
+			}, nil
+		}
+	} else {
+		return &extension.PortForwardResponse{
+			Response: &extension.PortForwardResponse_Result{
+				Result: toPortForward(added),
+			},
+		}, nil
+	}
+}
+
+func (e *grpcExtension) DeletePortForward(ctx context.Context, req *extension.DeletePortForwardRequest) (*extension.DoneResponse, error) {
+	e.incrementInflight()
+
+	defer e.decrementInflight()
+
+	return doneResponse(e.vmrun.DeletePortForward(req.Identifier, req.Protocol, int(req.HostPort)))
+}
+
+func toIPAllocation(allocation *service.IPAllocation) *extension.IPAllocation {
+	return &extension.IPAllocation{
+		Vmnet:   allocation.Vmnet,
+		Address: allocation.Address,
+		Owner:   allocation.Owner,
+	}
+}
+
+func (e *grpcExtension) ListIPAllocations(ctx context.Context, req *extension.IPAllocationsRequest) (*extension.IPAllocationsResponse, error) {
+	e.incrementInflight()
+
+	defer e.decrementInflight()
+
+	if allocations, err := e.vmrun.ListIPAllocations(req.Vmnet); err != nil {
+		if clientError, err := extensionError(err); err != nil {
+			return nil, err
+		} else {
+			return &extension.IPAllocationsResponse{
+				Response: &extension.IPAllocationsResponse_Error{
+					Error: clientError,
+				},
+			}, nil
+		}
+	} else {
+		result := make([]*extension.IPAllocation, 0, len(allocations))
+
+		for _, allocation := range allocations {
+			result = append(result, toIPAllocation(allocation))
+		}
+
+		return &extension.IPAllocationsResponse{
+			Response: &extension.IPAllocationsResponse_Result{
+				Result: &extension.IPAllocationsReply{
+					Allocations: result,
+				},
+			},
+		}, nil
+	}
+}
+
+func (e *grpcExtension) AllocateIP(ctx context.Context, req *extension.IPAllocation) (*extension.IPAllocationResponse, error) {
+	e.incrementInflight()
+
+	defer e.decrementInflight()
+
+	if allocation, err := e.vmrun.AllocateIP(req.Vmnet, req.Owner, req.Address); err != nil {
+		if clientError, err := extensionError(err); err != nil {
+			return nil, err
+		} else {
+			return &extension.IPAllocationResponse{
+				Response: &extension.IPAllocationResponse_Error{
+					Error: clientError,
+				},
+			}, nil
+		}
+	} else {
+		return &extension.IPAllocationResponse{
+			Response: &extension.IPAllocationResponse_Result{
+				Result: toIPAllocation(allocation),
+			},
+		}, nil
+	}
+}
+
+func (e *grpcExtension) ReleaseIP(ctx context.Context, req *extension.IPAllocation) (*extension.DoneResponse, error) {
+	e.incrementInflight()
+
+	defer e.decrementInflight()
+
+	return doneResponse(e.vmrun.ReleaseIP(req.Vmnet, req.Address))
+}
+
+// WaitForIP is the WaitForIP of api.proto with the selector of the awaited network interface
+func (e *grpcExtension) WaitForIP(ctx context.Context, req *extension.WaitForIPRequest) (*extension.WaitForIPResponse, error) {
+	e.incrementInflight()
+
+	defer e.decrementInflight()
+
+	if addresses, err := e.vmrun.WaitForIP(req.Identifier, req.Network, time.Duration(req.TimeoutInSeconds)*time.Second); err != nil {
+		if clientError, err := extensionError(err); err != nil {
+			return nil, err
+		} else {
+			return &extension.WaitForIPResponse{
+				Response: &extension.WaitForIPResponse_Error{
+					Error: clientError,
+				},
+			}, nil
+		}
+	} else {
+		interfaces := make([]*extension.GuestInterface, 0, len(addresses.Interfaces))
+
+		for _, inf := range addresses.Interfaces {
+			interfaces = append(interfaces, &extension.GuestInterface{
+				Index:      int32(inf.Index),
+				Macaddress: inf.MacAddress,
+				Addresses:  inf.Addresses,
+			})
+		}
+
+		return &extension.WaitForIPResponse{
+			Response: &extension.WaitForIPResponse_Result{
+				Result: &extension.WaitForIPReply{
+					Address:    addresses.Address,
+					Interfaces: interfaces,
+				},
+			},
+		}, nil
+	}
+}
+
+func (e *grpcExtension) RestoreVMX(ctx context.Context, req *extension.RestoreVMXRequest) (*extension.VirtualMachineResponse, error) {
+	e.incrementInflight()
+
+	defer e.decrementInflight()
+
+	generations := int(req.Generations)
+
+	if generations == 0 {
+		generations = 1
+	}
+
+	if vm, err := e.vmrun.RestoreVMX(req.Identifier, generations); err != nil {
+		if clientError, err := extensionError(err); err != nil {
+			return nil, err
+		} else {
+			return &extension.VirtualMachineResponse{
+				Response: &extension.VirtualMachineResponse_Error{
+					Error: clientError,
+				},
+			}, nil
+		}
+	} else {
+		return &extension.VirtualMachineResponse{
+			Response: &extension.VirtualMachineResponse_Result{
+				Result: toVirtualMachine(vm),
+			},
+		}, nil
+	}
+}
+
+func toVMXChanges(changes []utils.VMXChange) []*extension.VMXChange {
+	result := make([]*extension.VMXChange, 0, len(changes))
+
+	for _, change := range changes {
+		result = append(result, &extension.VMXChange{
+			Key:   change.Key,
+			Value: change.Value,
+			Old:   change.Old,
+		})
+	}
+
+	return result
+}
+
+func (e *grpcExtension) PreviewCreate(ctx context.Context, req *extension.CreateRequest) (*extension.PreviewCreateResponse, error) {
+	e.incrementInflight()
+
+	defer e.decrementInflight()
+
+	networks := make([]*service.NetworkInterface, 0, len(req.Networks))
+
+	for _, network := range req.Networks {
+		networks = append(networks, &service.NetworkInterface{
+			MacAddress:     network.Macaddress,
+			Vnet:           network.Vnet,
+			ConnectionType: network.Type,
+			Device:         network.Device,
+			BsdName:        network.BsdName,
+			DisplayName:    network.DisplayName,
+		})
+	}
+
+	request := &service.CreateVirtualMachine{
+		Template:     req.Template,
+		Name:         req.Name,
+		Vcpus:        int(req.Vcpus),
+		Memory:       int(req.Memory),
+		DiskSizeInMb: int(req.DiskSizeInMb),
+		Networks:     networks,
+		GuestInfos:   req.GuestInfos,
+		Linked:       req.Linked,
+		Register:     req.Register,
+		Autostart:    req.Autostart,
+	}
+
+	if diff, err := e.vmrun.PreviewCreate(request); err != nil {
+		if clientError, err := extensionError(err); err != nil {
+			return nil, err
+		} else {
+			return &extension.PreviewCreateResponse{
+				Response: &extension.PreviewCreateResponse_Error{
+					Error: clientError,
+				},
+			}, nil
+		}
+	} else {
+		return &extension.PreviewCreateResponse{
+			Response: &extension.PreviewCreateResponse_Result{
+				Result: &extension.PreviewCreateReply{
+					Added:   toVMXChanges(diff.Added),
+					Changed: toVMXChanges(diff.Changed),
+					Removed: toVMXChanges(diff.Removed),
+				},
+			},
+		}, nil
+	}
+}
@@ -0,0 +1,47 @@
+package server
+
+import (
+	"os"
+	"path"
+
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/service"
+)
+
+// copyContentToGuest write the content in a temporary file of the host, then copy it to the guest
+func copyContentToGuest(vmrun service.Vmrun, vmuuid string, creds *service.GuestCredentials, content []byte, guestPath string) (bool, error) {
+	file, err := os.CreateTemp("", "guest-upload-*")
+
+	if err != nil {
+		return false, err
+	}
+
+	defer os.Remove(file.Name())
+
+	_, err = file.Write(content)
+	file.Close()
+
+	if err != nil {
+		return false, err
+	}
+
+	return vmrun.CopyFileFromHostToGuest(vmuuid, creds, file.Name(), guestPath)
+}
+
+// copyContentFromGuest copy a guest file in a temporary directory of the host and return its content
+func copyContentFromGuest(vmrun service.Vmrun, vmuuid string, creds *service.GuestCredentials, guestPath string) ([]byte, error) {
+	dir, err := os.MkdirTemp("", "guest-download-*")
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer os.RemoveAll(dir)
+
+	hostPath := path.Join(dir, "content")
+
+	if _, err = vmrun.CopyFileFromGuestToHost(vmuuid, creds, guestPath, hostPath); err != nil {
+		return nil, err
+	}
+
+	return os.ReadFile(hostPath)
+}
@@ -6,11 +6,12 @@ import (
 	"net/http"
 	"regexp"
 	"strconv"
-	"sync"
 
 	"github.com/Fred78290/vmware-desktop-autoscaler-utility/service"
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/status"
 	hclog "github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/vagrant-vmware-desktop/go_src/vagrant-vmware-utility/version"
+	codes "google.golang.org/grpc/codes"
 )
 
 const API_CONTENT_TYPE = "application/vnd.hashicorp.vagrant.vmware.rest-v1+json"
@@ -21,11 +22,10 @@ type route struct {
 }
 
 type RegexpHandler struct {
-	routes  []*route
-	logger  hclog.Logger
-	api     *Api
-	vmrun   service.Vmrun
-	netLock sync.Mutex
+	routes []*route
+	logger hclog.Logger
+	api    *Api
+	vmrun  service.Vmrun
 }
 
 func NewRegexpHandler(api *Api, vmrun service.Vmrun, logger hclog.Logger) *RegexpHandler {
@@ -86,6 +86,34 @@ func (r *RegexpHandler) notSupported(writ http.ResponseWriter) {
 	r.error(writ, "not supported method", http.StatusMethodNotAllowed)
 }
 
+// statusError write the error with the HTTP code matching its gRPC code, fallback for the other codes
+func (r *RegexpHandler) statusError(writ http.ResponseWriter, err error, fallback int) {
+	code := fallback
+
+	if st, ok := status.FromError(err); ok {
+		switch st.Code() {
+		case codes.InvalidArgument:
+			code = http.StatusBadRequest
+		case codes.NotFound:
+			code = http.StatusNotFound
+		case codes.AlreadyExists, codes.FailedPrecondition, codes.Aborted:
+			code = http.StatusConflict
+		case codes.PermissionDenied:
+			code = http.StatusForbidden
+		case codes.ResourceExhausted:
+			code = http.StatusTooManyRequests
+		case codes.Unimplemented:
+			code = http.StatusNotImplemented
+		case codes.Unavailable:
+			code = http.StatusServiceUnavailable
+		case codes.DeadlineExceeded:
+			code = http.StatusGatewayTimeout
+		}
+	}
+
+	r.error(writ, err.Error(), code)
+}
+
 func (r *RegexpHandler) invalidDriver(writ http.ResponseWriter) {
 	r.error(writ, "Validation failure: "+r.api.Driver.GetDriver().ValidationReason(), http.StatusInternalServerError)
 }
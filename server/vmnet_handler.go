@@ -0,0 +1,67 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/driver"
+	vagrant_driver "github.com/hashicorp/vagrant-vmware-desktop/go_src/vagrant-vmware-utility/driver"
+)
+
+func (r *RegexpHandler) handleVmnets(wr http.ResponseWriter, req *http.Request) {
+	var vnet vagrant_driver.Vmnet
+
+	if req.Method == "GET" {
+		r.logger.Debug("list vmnets")
+
+		if vmnets, err := driver.ListVmnets(r.api.Driver); err != nil {
+			r.statusError(wr, err, http.StatusInternalServerError)
+		} else {
+			r.respond(wr, newResponse(vmnets), http.StatusOK)
+		}
+	} else if req.Method == "POST" {
+		if err := r.readBody(req, &vnet); err != nil {
+			r.error(wr, err.Error(), http.StatusInternalServerError)
+		} else if err = driver.CreateVmnet(r.api.Driver, &vnet); err != nil {
+			r.statusError(wr, err, http.StatusInternalServerError)
+		} else {
+			r.logger.Info("vmnet created", "name", vnet.Name, "type", vnet.Type, "subnet", vnet.Subnet, "mask", vnet.Mask)
+			r.respond(wr, newResponse(&vnet), http.StatusOK)
+		}
+	} else {
+		r.notSupported(wr)
+	}
+}
+
+func (r *RegexpHandler) handleVmnet(wr http.ResponseWriter, req *http.Request) {
+	var update vagrant_driver.Vmnet
+
+	name := r.pathParams(req.URL.Path)["name"]
+
+	r.logger.Debug("vmnet by name", "name", name)
+
+	if req.Method == "GET" {
+		if vnet, err := driver.VmnetByName(r.api.Driver, name); err != nil {
+			r.statusError(wr, err, http.StatusInternalServerError)
+		} else {
+			r.respond(wr, newResponse(vnet), http.StatusOK)
+		}
+	} else if req.Method == "PUT" {
+		if err := r.readBody(req, &update); err != nil {
+			r.error(wr, err.Error(), http.StatusInternalServerError)
+		} else if vnet, err := driver.UpdateVmnet(r.api.Driver, name, &update); err != nil {
+			r.statusError(wr, err, http.StatusInternalServerError)
+		} else {
+			r.logger.Info("vmnet updated", "name", vnet.Name, "type", vnet.Type, "subnet", vnet.Subnet, "mask", vnet.Mask)
+			r.respond(wr, newResponse(vnet), http.StatusOK)
+		}
+	} else if req.Method == "DELETE" {
+		if err := driver.DeleteVmnet(r.api.Driver, name); err != nil {
+			r.statusError(wr, err, http.StatusInternalServerError)
+		} else {
+			r.logger.Info("vmnet deleted", "name", name)
+			r.respond(wr, newDoneResponse(true), http.StatusOK)
+		}
+	} else {
+		r.notSupported(wr)
+	}
+}
@@ -43,6 +43,44 @@ type PowerOffMode struct {
 	Mode string
 }
 
+type ParamDisk struct {
+	Slot       string
+	DeleteFile bool
+}
+
+type ParamGuest struct {
+	Username    string
+	Password    string
+	Program     string
+	Arguments   []string
+	Interpreter string
+	Script      string
+	NoWait      bool
+	GuestPath   string
+	Content     []byte
+}
+
+func (p *ParamGuest) credentials() *service.GuestCredentials {
+	return &service.GuestCredentials{
+		Username: p.Username,
+		Password: p.Password,
+	}
+}
+
+type ParamGuestInfo struct {
+	Value string
+}
+
+type ParamRestore struct {
+	Generations int
+}
+
+type ParamSnapshot struct {
+	Name           string
+	Description    string
+	DeleteChildren bool
+}
+
 var hopHeaders = []string{
 	"Connection",
 	"Keep-Alive",
@@ -108,11 +146,39 @@ func appendHostToXForwardHeader(header http.Header, host string) {
 	header.Set("X-Forwarded-For", host)
 }
 
-func (r *RegexpHandler) handleVmrestProxy(wr http.ResponseWriter, req *http.Request) {
+// proxyLock lock the VM or the vmnet targeted by a vmrest request with the locks of the service, the reads take the shared lock.
+// The other requests are not locked, vmrest serialize them.
+func (r *RegexpHandler) proxyLock(req *http.Request) func() {
+	parts := strings.Split(strings.TrimPrefix(req.URL.Path, "/api/"), "/")
+	read := req.Method == "GET"
+
+	if len(parts) > 1 && parts[0] == "vms" && parts[1] != "" {
+		if read {
+			return r.vmrun.RLockVM(parts[1])
+		}
+
+		return r.vmrun.LockVM(parts[1])
+	} else if parts[0] == "vmnet" || parts[0] == "vmnets" {
+		name := ""
+
+		// POST /vmnets create a vmnet, it lock the whole inventory
+		if len(parts) > 1 && parts[0] == "vmnet" {
+			name = parts[1]
+		}
+
+		if read {
+			return r.vmrun.RLockVmnet(name)
+		}
+
+		return r.vmrun.LockVmnet(name)
+	}
+
+	return func() {}
+}
 
-	r.netLock.Lock()
+func (r *RegexpHandler) handleVmrestProxy(wr http.ResponseWriter, req *http.Request) {
 
-	defer r.netLock.Unlock()
+	defer r.proxyLock(req)()
 
 	httperror := func(code int, err error) {
 		wr.Header().Set("Content-Type", driver.VMREST_CONTENT_TYPE)
@@ -182,15 +248,12 @@ func (r *RegexpHandler) handleCreateVirtualMachine(wr http.ResponseWriter, req *
 	var vmdefs service.CreateVirtualMachine
 
 	if req.Method == "POST" {
-		r.netLock.Lock()
-		defer r.netLock.Unlock()
-
 		r.logger.Debug("create vm")
 
 		if err := r.readBody(req, &vmdefs); err != nil {
 			r.error(wr, err.Error(), http.StatusInternalServerError)
 		} else if vm, err := r.vmrun.Create(&vmdefs); err != nil {
-			r.error(wr, err.Error(), http.StatusNotFound)
+			r.statusError(wr, err, http.StatusInternalServerError)
 		} else {
 			r.respond(wr, newResponse(&vm), http.StatusOK)
 		}
@@ -199,13 +262,28 @@ func (r *RegexpHandler) handleCreateVirtualMachine(wr http.ResponseWriter, req *
 	}
 }
 
+func (r *RegexpHandler) handlePreviewCreateVirtualMachine(wr http.ResponseWriter, req *http.Request) {
+	var vmdefs service.CreateVirtualMachine
+
+	if req.Method == "POST" {
+		r.logger.Debug("preview create vm")
+
+		if err := r.readBody(req, &vmdefs); err != nil {
+			r.error(wr, err.Error(), http.StatusInternalServerError)
+		} else if diff, err := r.vmrun.PreviewCreate(&vmdefs); err != nil {
+			r.statusError(wr, err, http.StatusInternalServerError)
+		} else {
+			r.respond(wr, newResponse(diff), http.StatusOK)
+		}
+	} else {
+		r.notSupported(wr)
+	}
+}
+
 func (r *RegexpHandler) handleDeleteVirtualMachine(wr http.ResponseWriter, req *http.Request) {
 	params := r.pathParams(req.URL.Path)
 
 	if req.Method == "DELETE" {
-		r.netLock.Lock()
-		defer r.netLock.Unlock()
-
 		r.logger.Debug("vm delete request", "vmuuid", params["vmuuid"])
 
 		if done, err := r.vmrun.Delete(params["vmuuid"]); err != nil {
@@ -222,9 +300,6 @@ func (r *RegexpHandler) handlePowerOnVirtualMachine(wr http.ResponseWriter, req
 	params := r.pathParams(req.URL.Path)
 
 	if req.Method == "PUT" {
-		r.netLock.Lock()
-		defer r.netLock.Unlock()
-
 		r.logger.Debug("vm power on", "vmuuid", params["vmuuid"])
 
 		if done, err := r.vmrun.PowerOn(params["vmuuid"]); err != nil {
@@ -242,8 +317,6 @@ func (r *RegexpHandler) handlePowerOffVirtualMachine(wr http.ResponseWriter, req
 
 	if req.Method == "PUT" {
 		var mode PowerOffMode
-		r.netLock.Lock()
-		defer r.netLock.Unlock()
 
 		r.logger.Debug("vm power off", "vmuuid", params["vmuuid"])
 
@@ -263,15 +336,12 @@ func (r *RegexpHandler) handlePowerStateVirtualMachine(wr http.ResponseWriter, r
 	params := r.pathParams(req.URL.Path)
 
 	if req.Method == "GET" {
-		r.netLock.Lock()
-		defer r.netLock.Unlock()
-
 		r.logger.Debug("vm power state", "vmuuid", params["vmuuid"])
 
-		if done, err := r.vmrun.PowerState(params["vmuuid"]); err != nil {
+		if state, err := r.vmrun.PowerState(params["vmuuid"]); err != nil {
 			r.error(wr, err.Error(), http.StatusNotFound)
 		} else {
-			r.respond(wr, newResponseWithKeyValue("powered", done), http.StatusOK)
+			r.respond(wr, newResponseWithKeyValue("powered", state.Powered(), "powerState", state), http.StatusOK)
 		}
 	} else {
 		r.notSupported(wr)
@@ -282,9 +352,6 @@ func (r *RegexpHandler) handleShutdownGuestVirtualMachine(wr http.ResponseWriter
 	params := r.pathParams(req.URL.Path)
 
 	if req.Method == "PUT" {
-		r.netLock.Lock()
-		defer r.netLock.Unlock()
-
 		r.logger.Debug("vm shutdown", "vmuuid", params["vmuuid"])
 
 		if done, err := r.vmrun.ShutdownGuest(params["vmuuid"]); err != nil {
@@ -297,6 +364,78 @@ func (r *RegexpHandler) handleShutdownGuestVirtualMachine(wr http.ResponseWriter
 	}
 }
 
+func (r *RegexpHandler) handleSuspendVirtualMachine(wr http.ResponseWriter, req *http.Request) {
+	params := r.pathParams(req.URL.Path)
+
+	if req.Method == "PUT" {
+		var mode PowerOffMode
+
+		r.logger.Debug("vm suspend", "vmuuid", params["vmuuid"])
+
+		if err := r.readBody(req, &mode); err != nil {
+			r.error(wr, err.Error(), http.StatusInternalServerError)
+		} else if done, err := r.vmrun.Suspend(params["vmuuid"], mode.Mode); err != nil {
+			r.statusError(wr, err, http.StatusInternalServerError)
+		} else {
+			r.respond(wr, newDoneResponse(done), http.StatusOK)
+		}
+	} else {
+		r.notSupported(wr)
+	}
+}
+
+func (r *RegexpHandler) handlePauseVirtualMachine(wr http.ResponseWriter, req *http.Request) {
+	params := r.pathParams(req.URL.Path)
+
+	if req.Method == "PUT" {
+		r.logger.Debug("vm pause", "vmuuid", params["vmuuid"])
+
+		if done, err := r.vmrun.Pause(params["vmuuid"]); err != nil {
+			r.statusError(wr, err, http.StatusInternalServerError)
+		} else {
+			r.respond(wr, newDoneResponse(done), http.StatusOK)
+		}
+	} else {
+		r.notSupported(wr)
+	}
+}
+
+func (r *RegexpHandler) handleUnpauseVirtualMachine(wr http.ResponseWriter, req *http.Request) {
+	params := r.pathParams(req.URL.Path)
+
+	if req.Method == "PUT" {
+		r.logger.Debug("vm unpause", "vmuuid", params["vmuuid"])
+
+		if done, err := r.vmrun.Unpause(params["vmuuid"]); err != nil {
+			r.statusError(wr, err, http.StatusInternalServerError)
+		} else {
+			r.respond(wr, newDoneResponse(done), http.StatusOK)
+		}
+	} else {
+		r.notSupported(wr)
+	}
+}
+
+func (r *RegexpHandler) handleResetVirtualMachine(wr http.ResponseWriter, req *http.Request) {
+	params := r.pathParams(req.URL.Path)
+
+	if req.Method == "PUT" {
+		var mode PowerOffMode
+
+		r.logger.Debug("vm reset", "vmuuid", params["vmuuid"])
+
+		if err := r.readBody(req, &mode); err != nil {
+			r.error(wr, err.Error(), http.StatusInternalServerError)
+		} else if done, err := r.vmrun.Reset(params["vmuuid"], mode.Mode); err != nil {
+			r.statusError(wr, err, http.StatusInternalServerError)
+		} else {
+			r.respond(wr, newDoneResponse(done), http.StatusOK)
+		}
+	} else {
+		r.notSupported(wr)
+	}
+}
+
 func (r *RegexpHandler) handleStatusVirtualMachine(wr http.ResponseWriter, req *http.Request) {
 	r.handleVirtualMachineByUUID(wr, req)
 }
@@ -305,21 +444,19 @@ func (r *RegexpHandler) handleWaitForIP(wr http.ResponseWriter, req *http.Reques
 	params := r.pathParams(req.URL.Path)
 
 	if req.Method == "GET" {
-		r.netLock.Lock()
-		defer r.netLock.Unlock()
-
-		r.logger.Debug("vm wait for ip", "vmuuid", params["vmuuid"])
-
+		network := req.FormValue("network")
 		timeout := req.FormValue("timeout")
 
+		r.logger.Debug("vm wait for ip", "vmuuid", params["vmuuid"], "network", network)
+
 		if timeout == "" {
 			timeout = "600"
 		}
 
-		if address, err := r.vmrun.WaitForIP(params["vmuuid"], time.Duration(utils.StrToInt(timeout))*time.Second); err != nil {
-			r.error(wr, err.Error(), http.StatusNotFound)
+		if addresses, err := r.vmrun.WaitForIP(params["vmuuid"], network, time.Duration(utils.StrToInt(timeout))*time.Second); err != nil {
+			r.statusError(wr, err, http.StatusInternalServerError)
 		} else {
-			r.respond(wr, newResponseWithKeyValue("address", address), http.StatusOK)
+			r.respond(wr, newResponse(addresses), http.StatusOK)
 		}
 	} else {
 		r.notSupported(wr)
@@ -330,9 +467,6 @@ func (r *RegexpHandler) handleWaitForToolsRunning(wr http.ResponseWriter, req *h
 	params := r.pathParams(req.URL.Path)
 
 	if req.Method == "GET" {
-		r.netLock.Lock()
-		defer r.netLock.Unlock()
-
 		timeout := req.FormValue("timeout")
 
 		if timeout == "" {
@@ -355,9 +489,6 @@ func (r *RegexpHandler) handleSetAutoStart(wr http.ResponseWriter, req *http.Req
 	params := r.pathParams(req.URL.Path)
 
 	if req.Method == "PUT" {
-		r.netLock.Lock()
-		defer r.netLock.Unlock()
-
 		r.logger.Debug("vm set autostart", "vmuuid", params["vmuuid"], "autostart", params["autostart"])
 
 		if autostart, err := r.vmrun.SetAutoStart(params["vmuuid"], params["autostart"] == "true"); err != nil {
@@ -374,9 +505,6 @@ func (r *RegexpHandler) handleVirtualMachineByName(wr http.ResponseWriter, req *
 	params := r.pathParams(req.URL.Path)
 
 	if req.Method == "GET" {
-		r.netLock.Lock()
-		defer r.netLock.Unlock()
-
 		r.logger.Debug("vm by name", "name", params["name"])
 
 		var detail VMDetail
@@ -400,9 +528,6 @@ func (r *RegexpHandler) handleVirtualMachineByUUID(wr http.ResponseWriter, req *
 	params := r.pathParams(req.URL.Path)
 
 	if req.Method == "GET" {
-		r.netLock.Lock()
-		defer r.netLock.Unlock()
-
 		r.logger.Debug("vm by uuid", "vmuuid", params["vmuuid"])
 
 		var detail VMDetail
@@ -417,6 +542,40 @@ func (r *RegexpHandler) handleVirtualMachineByUUID(wr http.ResponseWriter, req *
 			detail.EthernetCards = status.EthernetCards
 			r.respond(wr, newResponse(&detail), http.StatusOK)
 		}
+	} else if req.Method == "PATCH" {
+		var spec service.ReconfigureVirtualMachine
+
+		r.logger.Debug("vm reconfigure", "vmuuid", params["vmuuid"])
+
+		if err := r.readBody(req, &spec); err != nil {
+			r.error(wr, err.Error(), http.StatusInternalServerError)
+		} else if vm, err := r.vmrun.Reconfigure(params["vmuuid"], &spec); err != nil {
+			r.statusError(wr, err, http.StatusInternalServerError)
+		} else {
+			r.respond(wr, newResponse(vm), http.StatusOK)
+		}
+	} else {
+		r.notSupported(wr)
+	}
+}
+
+func (r *RegexpHandler) handleRestoreVMX(wr http.ResponseWriter, req *http.Request) {
+	params := r.pathParams(req.URL.Path)
+
+	if req.Method == "PUT" {
+		restore := ParamRestore{
+			Generations: 1,
+		}
+
+		r.logger.Debug("vm restore vmx", "vmuuid", params["vmuuid"])
+
+		if err := r.readBody(req, &restore); err != nil {
+			r.error(wr, err.Error(), http.StatusInternalServerError)
+		} else if vm, err := r.vmrun.RestoreVMX(params["vmuuid"], restore.Generations); err != nil {
+			r.statusError(wr, err, http.StatusInternalServerError)
+		} else {
+			r.respond(wr, newResponse(vm), http.StatusOK)
+		}
 	} else {
 		r.notSupported(wr)
 	}
@@ -427,9 +586,6 @@ func (r *RegexpHandler) handleNetworkInterface(wr http.ResponseWriter, req *http
 
 	params := r.pathParams(req.URL.Path)
 
-	r.netLock.Lock()
-	defer r.netLock.Unlock()
-
 	vmuuid := params["vmuuid"]
 
 	r.logger.Debug("vnet by uuid", "vmuuid", vmuuid)
@@ -461,11 +617,369 @@ func (r *RegexpHandler) handleNetworkInterface(wr http.ResponseWriter, req *http
 	}
 }
 
-func (r *RegexpHandler) handleListVirtualMachines(wr http.ResponseWriter, req *http.Request) {
+func (r *RegexpHandler) handleRemoveNetworkInterface(wr http.ResponseWriter, req *http.Request) {
+	params := r.pathParams(req.URL.Path)
+
+	if req.Method == "DELETE" {
+		r.logger.Debug("vm remove nic", "vmuuid", params["vmuuid"], "index", params["index"])
+
+		if err := r.vmrun.RemoveNetworkInterface(params["vmuuid"], utils.StrToInt(params["index"])); err != nil {
+			r.statusError(wr, err, http.StatusInternalServerError)
+		} else {
+			r.respond(wr, newDoneResponse(true), http.StatusOK)
+		}
+	} else {
+		r.notSupported(wr)
+	}
+}
+
+func (r *RegexpHandler) handlePortForwards(wr http.ResponseWriter, req *http.Request) {
+	params := r.pathParams(req.URL.Path)
+
+	vmuuid := params["vmuuid"]
+
+	r.logger.Debug("vm port forward", "vmuuid", vmuuid, "method", req.Method)
+
 	if req.Method == "GET" {
-		r.netLock.Lock()
-		defer r.netLock.Unlock()
+		if forwards, err := r.vmrun.ListPortForwards(vmuuid); err != nil {
+			r.statusError(wr, err, http.StatusInternalServerError)
+		} else {
+			r.respond(wr, newResponse(forwards), http.StatusOK)
+		}
+	} else if req.Method == "POST" {
+		var forward service.PortForward
+
+		if err := r.readBody(req, &forward); err != nil {
+			r.error(wr, err.Error(), http.StatusInternalServerError)
+		} else if added, err := r.vmrun.AddPortForward(vmuuid, &forward); err != nil {
+			r.statusError(wr, err, http.StatusInternalServerError)
+		} else {
+			r.respond(wr, newResponse(added), http.StatusOK)
+		}
+	} else if req.Method == "DELETE" {
+		var forward service.PortForward
 
+		if err := r.readBody(req, &forward); err != nil {
+			r.error(wr, err.Error(), http.StatusInternalServerError)
+		} else if done, err := r.vmrun.DeletePortForward(vmuuid, forward.Protocol, forward.HostPort); err != nil {
+			r.statusError(wr, err, http.StatusInternalServerError)
+		} else {
+			r.respond(wr, newDoneResponse(done), http.StatusOK)
+		}
+	} else {
+		r.notSupported(wr)
+	}
+}
+
+func (r *RegexpHandler) handleIPAllocations(wr http.ResponseWriter, req *http.Request) {
+	var allocation service.IPAllocation
+
+	vmnet := r.pathParams(req.URL.Path)["vmnet"]
+
+	r.logger.Debug("ip allocations", "vmnet", vmnet, "method", req.Method)
+
+	if req.Method == "GET" {
+		if allocations, err := r.vmrun.ListIPAllocations(vmnet); err != nil {
+			r.statusError(wr, err, http.StatusInternalServerError)
+		} else {
+			r.respond(wr, newResponse(allocations), http.StatusOK)
+		}
+	} else if vmnet == "" {
+		r.notSupported(wr)
+	} else if req.Method == "POST" {
+		if err := r.readBody(req, &allocation); err != nil {
+			r.error(wr, err.Error(), http.StatusInternalServerError)
+		} else if allocated, err := r.vmrun.AllocateIP(vmnet, allocation.Owner, allocation.Address); err != nil {
+			r.statusError(wr, err, http.StatusInternalServerError)
+		} else {
+			r.respond(wr, newResponse(allocated), http.StatusOK)
+		}
+	} else if req.Method == "DELETE" {
+		if err := r.readBody(req, &allocation); err != nil {
+			r.error(wr, err.Error(), http.StatusInternalServerError)
+		} else if done, err := r.vmrun.ReleaseIP(vmnet, allocation.Address); err != nil {
+			r.statusError(wr, err, http.StatusInternalServerError)
+		} else {
+			r.respond(wr, newDoneResponse(done), http.StatusOK)
+		}
+	} else {
+		r.notSupported(wr)
+	}
+}
+
+func (r *RegexpHandler) handleSnapshot(wr http.ResponseWriter, req *http.Request) {
+	var snapshot ParamSnapshot
+
+	params := r.pathParams(req.URL.Path)
+
+	vmuuid := params["vmuuid"]
+
+	r.logger.Debug("vm snapshot", "vmuuid", vmuuid, "method", req.Method)
+
+	if req.Method == "GET" {
+		if snapshots, err := r.vmrun.ListSnapshots(vmuuid); err != nil {
+			r.statusError(wr, err, http.StatusInternalServerError)
+		} else {
+			r.respond(wr, newResponse(snapshots), http.StatusOK)
+		}
+	} else if req.Method == "POST" {
+		if err := r.readBody(req, &snapshot); err != nil {
+			r.error(wr, err.Error(), http.StatusInternalServerError)
+		} else if result, err := r.vmrun.TakeSnapshot(vmuuid, snapshot.Name, snapshot.Description); err != nil {
+			r.statusError(wr, err, http.StatusInternalServerError)
+		} else {
+			r.respond(wr, newResponse(result), http.StatusOK)
+		}
+	} else if req.Method == "PUT" {
+		if err := r.readBody(req, &snapshot); err != nil {
+			r.error(wr, err.Error(), http.StatusInternalServerError)
+		} else if done, err := r.vmrun.RevertToSnapshot(vmuuid, snapshot.Name); err != nil {
+			r.statusError(wr, err, http.StatusInternalServerError)
+		} else {
+			r.respond(wr, newDoneResponse(done), http.StatusOK)
+		}
+	} else if req.Method == "DELETE" {
+		if err := r.readBody(req, &snapshot); err != nil {
+			r.error(wr, err.Error(), http.StatusInternalServerError)
+		} else if done, err := r.vmrun.DeleteSnapshot(vmuuid, snapshot.Name, snapshot.DeleteChildren); err != nil {
+			r.statusError(wr, err, http.StatusInternalServerError)
+		} else {
+			r.respond(wr, newDoneResponse(done), http.StatusOK)
+		}
+	} else {
+		r.notSupported(wr)
+	}
+}
+
+func (r *RegexpHandler) handleDisk(wr http.ResponseWriter, req *http.Request) {
+	params := r.pathParams(req.URL.Path)
+
+	vmuuid := params["vmuuid"]
+
+	r.logger.Debug("vm disk", "vmuuid", vmuuid, "method", req.Method)
+
+	if req.Method == "GET" {
+		if disks, err := r.vmrun.ListDisks(vmuuid); err != nil {
+			r.statusError(wr, err, http.StatusInternalServerError)
+		} else {
+			r.respond(wr, newResponse(disks), http.StatusOK)
+		}
+	} else if req.Method == "POST" {
+		var request service.CreateVirtualDisk
+
+		if err := r.readBody(req, &request); err != nil {
+			r.error(wr, err.Error(), http.StatusInternalServerError)
+		} else if disk, err := r.vmrun.AddDisk(vmuuid, &request); err != nil {
+			r.statusError(wr, err, http.StatusInternalServerError)
+		} else {
+			r.respond(wr, newResponse(disk), http.StatusOK)
+		}
+	} else if req.Method == "DELETE" {
+		var disk ParamDisk
+
+		if err := r.readBody(req, &disk); err != nil {
+			r.error(wr, err.Error(), http.StatusInternalServerError)
+		} else if done, err := r.vmrun.RemoveDisk(vmuuid, disk.Slot, disk.DeleteFile); err != nil {
+			r.statusError(wr, err, http.StatusInternalServerError)
+		} else {
+			r.respond(wr, newDoneResponse(done), http.StatusOK)
+		}
+	} else {
+		r.notSupported(wr)
+	}
+}
+
+func (r *RegexpHandler) handleGuestProgram(wr http.ResponseWriter, req *http.Request) {
+	params := r.pathParams(req.URL.Path)
+
+	if req.Method == "POST" {
+		var guest ParamGuest
+
+		r.logger.Debug("vm run program in guest", "vmuuid", params["vmuuid"])
+
+		if err := r.readBody(req, &guest); err != nil {
+			r.error(wr, err.Error(), http.StatusInternalServerError)
+		} else if exitCode, err := r.vmrun.RunProgramInGuest(params["vmuuid"], guest.credentials(), guest.Program, guest.Arguments, guest.NoWait); err != nil {
+			r.statusError(wr, err, http.StatusInternalServerError)
+		} else {
+			r.respond(wr, newResponseWithKeyValue("exitCode", exitCode), http.StatusOK)
+		}
+	} else {
+		r.notSupported(wr)
+	}
+}
+
+func (r *RegexpHandler) handleGuestScript(wr http.ResponseWriter, req *http.Request) {
+	params := r.pathParams(req.URL.Path)
+
+	if req.Method == "POST" {
+		var guest ParamGuest
+
+		r.logger.Debug("vm run script in guest", "vmuuid", params["vmuuid"])
+
+		if err := r.readBody(req, &guest); err != nil {
+			r.error(wr, err.Error(), http.StatusInternalServerError)
+		} else if exitCode, err := r.vmrun.RunScriptInGuest(params["vmuuid"], guest.credentials(), guest.Interpreter, guest.Script, guest.NoWait); err != nil {
+			r.statusError(wr, err, http.StatusInternalServerError)
+		} else {
+			r.respond(wr, newResponseWithKeyValue("exitCode", exitCode), http.StatusOK)
+		}
+	} else {
+		r.notSupported(wr)
+	}
+}
+
+func (r *RegexpHandler) handleGuestUpload(wr http.ResponseWriter, req *http.Request) {
+	params := r.pathParams(req.URL.Path)
+
+	if req.Method == "POST" {
+		var guest ParamGuest
+
+		r.logger.Debug("vm copy file to guest", "vmuuid", params["vmuuid"])
+
+		if err := r.readBody(req, &guest); err != nil {
+			r.error(wr, err.Error(), http.StatusInternalServerError)
+		} else if done, err := copyContentToGuest(r.vmrun, params["vmuuid"], guest.credentials(), guest.Content, guest.GuestPath); err != nil {
+			r.statusError(wr, err, http.StatusInternalServerError)
+		} else {
+			r.respond(wr, newDoneResponse(done), http.StatusOK)
+		}
+	} else {
+		r.notSupported(wr)
+	}
+}
+
+func (r *RegexpHandler) handleGuestDownload(wr http.ResponseWriter, req *http.Request) {
+	params := r.pathParams(req.URL.Path)
+
+	if req.Method == "POST" {
+		var guest ParamGuest
+
+		r.logger.Debug("vm copy file from guest", "vmuuid", params["vmuuid"])
+
+		if err := r.readBody(req, &guest); err != nil {
+			r.error(wr, err.Error(), http.StatusInternalServerError)
+		} else if content, err := copyContentFromGuest(r.vmrun, params["vmuuid"], guest.credentials(), guest.GuestPath); err != nil {
+			r.statusError(wr, err, http.StatusInternalServerError)
+		} else {
+			r.respond(wr, newResponseWithKeyValue("content", content), http.StatusOK)
+		}
+	} else {
+		r.notSupported(wr)
+	}
+}
+
+func (r *RegexpHandler) handleGuestProcesses(wr http.ResponseWriter, req *http.Request) {
+	params := r.pathParams(req.URL.Path)
+
+	if req.Method == "POST" {
+		var guest ParamGuest
+
+		r.logger.Debug("vm list processes in guest", "vmuuid", params["vmuuid"])
+
+		if err := r.readBody(req, &guest); err != nil {
+			r.error(wr, err.Error(), http.StatusInternalServerError)
+		} else if processes, err := r.vmrun.ListProcessesInGuest(params["vmuuid"], guest.credentials()); err != nil {
+			r.statusError(wr, err, http.StatusInternalServerError)
+		} else {
+			r.respond(wr, newResponse(processes), http.StatusOK)
+		}
+	} else {
+		r.notSupported(wr)
+	}
+}
+
+func (r *RegexpHandler) handleListGuestInfos(wr http.ResponseWriter, req *http.Request) {
+	params := r.pathParams(req.URL.Path)
+
+	if req.Method == "GET" {
+		r.logger.Debug("vm list guestinfos", "vmuuid", params["vmuuid"])
+
+		if guestinfos, err := r.vmrun.ListGuestInfos(params["vmuuid"]); err != nil {
+			r.statusError(wr, err, http.StatusInternalServerError)
+		} else {
+			r.respond(wr, newResponse(guestinfos), http.StatusOK)
+		}
+	} else {
+		r.notSupported(wr)
+	}
+}
+
+func (r *RegexpHandler) handleGuestInfo(wr http.ResponseWriter, req *http.Request) {
+	params := r.pathParams(req.URL.Path)
+
+	vmuuid := params["vmuuid"]
+	key := params["key"]
+
+	r.logger.Debug("vm guestinfo", "vmuuid", vmuuid, "key", key, "method", req.Method)
+
+	if req.Method == "GET" {
+		if value, err := r.vmrun.GetGuestInfo(vmuuid, key); err != nil {
+			r.statusError(wr, err, http.StatusInternalServerError)
+		} else {
+			r.respond(wr, newResponseWithKeyValue(key, value), http.StatusOK)
+		}
+	} else if req.Method == "PUT" {
+		var guestinfo ParamGuestInfo
+
+		if err := r.readBody(req, &guestinfo); err != nil {
+			r.error(wr, err.Error(), http.StatusInternalServerError)
+		} else if done, err := r.vmrun.SetGuestInfo(vmuuid, key, guestinfo.Value); err != nil {
+			r.statusError(wr, err, http.StatusInternalServerError)
+		} else {
+			r.respond(wr, newDoneResponse(done), http.StatusOK)
+		}
+	} else {
+		r.notSupported(wr)
+	}
+}
+
+func (r *RegexpHandler) handleEvents(wr http.ResponseWriter, req *http.Request) {
+	if req.Method == "GET" {
+		flusher, ok := wr.(http.Flusher)
+
+		if !ok {
+			r.error(wr, "streaming unsupported", http.StatusInternalServerError)
+			return
+		}
+
+		r.logger.Debug("events subscribe", "remote", req.RemoteAddr)
+
+		events, unsubscribe := r.vmrun.Subscribe()
+		defer unsubscribe()
+
+		wr.Header().Set("Content-Type", "text/event-stream")
+		wr.Header().Set("Cache-Control", "no-cache")
+		wr.Header().Set("Connection", "keep-alive")
+		wr.WriteHeader(http.StatusOK)
+		flusher.Flush()
+
+		for {
+			select {
+			case <-req.Context().Done():
+				r.logger.Debug("events unsubscribe", "remote", req.RemoteAddr)
+				return
+			case <-r.api.closing:
+				return
+			case event, ok := <-events:
+				if !ok {
+					return
+				}
+
+				if _, err := fmt.Fprintf(wr, "event: %s\ndata: %s\n\n", event.Type, utils.ToJSON(event)); err != nil {
+					return
+				}
+
+				flusher.Flush()
+			}
+		}
+	} else {
+		r.notSupported(wr)
+	}
+}
+
+func (r *RegexpHandler) handleListVirtualMachines(wr http.ResponseWriter, req *http.Request) {
+	if req.Method == "GET" {
 		r.logger.Debug("list vm")
 
 		if vms, err := r.vmrun.ListVirtualMachines(); err != nil {
@@ -478,6 +992,16 @@ func (r *RegexpHandler) handleListVirtualMachines(wr http.ResponseWriter, req *h
 	}
 }
 
+func (r *RegexpHandler) handleListPools(wr http.ResponseWriter, req *http.Request) {
+	if req.Method == "GET" {
+		r.logger.Debug("list pools")
+
+		r.respond(wr, newResponse(r.vmrun.ListPools()), http.StatusOK)
+	} else {
+		r.notSupported(wr)
+	}
+}
+
 func (r *RegexpHandler) readBody(req *http.Request, target interface{}) error {
 	defer req.Body.Close()
 
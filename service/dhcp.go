@@ -0,0 +1,182 @@
+package service
+
+import (
+	"fmt"
+	"net"
+	"path"
+	"strings"
+
+	"github.com/Fred78290/vmrest-go-client/client/model"
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/status"
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/utils"
+	vagrant_utility "github.com/hashicorp/vagrant-vmware-desktop/go_src/vagrant-vmware-utility/utility"
+	codes "google.golang.org/grpc/codes"
+)
+
+// dhcpReservation is a static DHCP mapping of a MAC address on a vmnet
+type dhcpReservation struct {
+	vmnet string
+	mac   string
+	ip    string
+}
+
+// interfaceVmnet return the vmnet serving the DHCP of the interface
+func interfaceVmnet(inf *NetworkInterface, networks *model.Networks) (*model.Network, error) {
+	var name string
+
+	switch strings.ToLower(inf.ConnectionType) {
+	case "nat":
+		name = "vmnet8"
+	case "hostonly":
+		name = "vmnet1"
+	case "custom":
+		name = path.Base(inf.Vnet)
+	default:
+		return nil, fmt.Errorf("no DHCP service for connection type: %s", inf.ConnectionType)
+	}
+
+	for i := range networks.Vmnets {
+		if networks.Vmnets[i].Name == name {
+			return &networks.Vmnets[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("vmnet: %s, not found", name)
+}
+
+// checkAddress verify the requested address is an host address of the vmnet subnet
+func checkAddress(address string, network *model.Network) error {
+	ip := net.ParseIP(address).To4()
+	ipnet := parseSubnet(network.Subnet, network.Mask)
+
+	if ip == nil {
+		return fmt.Errorf("invalid IPv4 address: %s", address)
+	} else if !utils.StrToBool(network.Dhcp) {
+		return fmt.Errorf("DHCP is disabled on vmnet: %s", network.Name)
+	} else if ipnet == nil {
+		return fmt.Errorf("vmnet: %s, has no subnet", network.Name)
+	} else if !ipnet.Contains(ip) {
+		return fmt.Errorf("address: %s is not in the subnet: %s of vmnet: %s", address, ipnet.String(), network.Name)
+	} else if first, last := hostRange(ipnet); ipToUint32(ip) < first || ipToUint32(ip) > last {
+		return fmt.Errorf("address: %s is not an host address of vmnet: %s", address, network.Name)
+	}
+
+	return nil
+}
+
+// requestedReservations validate the addresses requested by the interfaces of the create request
+func (v *VmrunExe) requestedReservations(request *CreateVirtualMachine) ([]dhcpReservation, error) {
+	var networks *model.Networks
+	var err error
+
+	reservations := make([]dhcpReservation, 0, len(request.Networks))
+
+	for card, inf := range request.Networks {
+		if inf.Address == "" {
+			continue
+		}
+
+		// Like the vmrest driver, Big Sur and later don't support DHCP reservations
+		if vagrant_utility.IsBigSurMin() {
+			return nil, status.Errorf(codes.FailedPrecondition, "failed to reserve address for NIC: %d, reason: DHCP reservations are not available on this platform", card)
+		}
+
+		if networks == nil {
+			if networks, err = v.client.GetAllNetworks(); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to list networks, reason: %v", err)
+			}
+		}
+
+		if network, err := interfaceVmnet(inf, networks); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to reserve address for NIC: %d, reason: %v", card, err)
+		} else if err = checkAddress(inf.Address, network); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to reserve address for NIC: %d, reason: %v", card, err)
+		} else if _, err := net.ParseMAC(inf.MacAddress); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to reserve address for NIC: %d, reason: a static MAC address is required", card)
+		} else if mactoips, err := v.client.GetMACToIPs(network.Name); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list DHCP reservations of vmnet: %s, reason: %v", network.Name, err)
+		} else {
+			mac := strings.ToLower(inf.MacAddress)
+
+			for _, mactoip := range mactoips.Mactoips {
+				if mactoip.Ip == inf.Address && strings.ToLower(mactoip.Mac) != mac {
+					return nil, status.Errorf(codes.AlreadyExists, "address: %s on vmnet: %s, already reserved for: %s", inf.Address, network.Name, mactoip.Mac)
+				}
+			}
+
+			if owner, found := v.ipam.owner(network.Name, inf.Address); found && owner != request.Name {
+				return nil, status.Errorf(codes.AlreadyExists, "address: %s on vmnet: %s, already allocated to: %s", inf.Address, network.Name, owner)
+			}
+
+			for _, other := range reservations {
+				if other.vmnet == network.Name && other.ip == inf.Address {
+					return nil, status.Errorf(codes.InvalidArgument, "address: %s on vmnet: %s, requested twice", inf.Address, network.Name)
+				}
+			}
+
+			reservations = append(reservations, dhcpReservation{vmnet: network.Name, mac: mac, ip: inf.Address})
+		}
+	}
+
+	return reservations, nil
+}
+
+// reserveAddresses add the DHCP reservations, the added ones are rolled back on failure
+func (v *VmrunExe) reserveAddresses(reservations []dhcpReservation) error {
+	for index, reservation := range reservations {
+		if _, err := v.client.UpdateMacToIP(reservation.vmnet, reservation.mac, &model.MacToIpParameter{IP: reservation.ip}); err != nil {
+			v.releaseAddresses(reservations[:index])
+
+			return status.Errorf(codes.Internal, "failed to reserve address: %s on vmnet: %s, reason: %v", reservation.ip, reservation.vmnet, err)
+		}
+
+		v.logger.Debug("dhcp address reserved", "vmnet", reservation.vmnet, "mac", reservation.mac, "ip", reservation.ip)
+	}
+
+	return nil
+}
+
+// releaseAddresses remove the DHCP reservations, an empty IP remove the mapping
+func (v *VmrunExe) releaseAddresses(reservations []dhcpReservation) {
+	for _, reservation := range reservations {
+		if _, err := v.client.UpdateMacToIP(reservation.vmnet, reservation.mac, &model.MacToIpParameter{IP: ""}); err != nil {
+			v.logger.Warn("unable to release dhcp address", "vmnet", reservation.vmnet, "mac", reservation.mac, "ip", reservation.ip, "error", err)
+		} else {
+			v.logger.Debug("dhcp address released", "vmnet", reservation.vmnet, "mac", reservation.mac, "ip", reservation.ip)
+		}
+	}
+}
+
+// vmReservations collect the DHCP reservations of the MAC addresses of the VM
+func (v *VmrunExe) vmReservations(vm *VirtualMachine) []dhcpReservation {
+	macs := make(map[string]bool)
+	reservations := make([]dhcpReservation, 0)
+
+	if vmx, err := utils.LoadVMX(vm.Path); err != nil {
+		v.logger.Debug("unable to read mac addresses", "path", vm.Path, "error", err)
+	} else {
+		for _, ethernet := range utils.ReadEthernets(vmx) {
+			if mac := ethernet.MacAddress(); mac != "" {
+				macs[strings.ToLower(mac)] = true
+			}
+		}
+	}
+
+	if len(macs) > 0 {
+		if networks, err := v.client.GetAllNetworks(); err != nil {
+			v.logger.Warn("unable to list networks", "error", err)
+		} else {
+			for _, network := range networks.Vmnets {
+				if mactoips, err := v.client.GetMACToIPs(network.Name); err == nil {
+					for _, mactoip := range mactoips.Mactoips {
+						if macs[strings.ToLower(mactoip.Mac)] {
+							reservations = append(reservations, dhcpReservation{vmnet: network.Name, mac: mactoip.Mac, ip: mactoip.Ip})
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return reservations
+}
@@ -0,0 +1,372 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/status"
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/utils"
+	vagrant_utility "github.com/hashicorp/vagrant-vmware-desktop/go_src/vagrant-vmware-utility/utility"
+	codes "google.golang.org/grpc/codes"
+)
+
+const (
+	sectorSize         = 512
+	sparseMagic        = "KDMV"
+	vdiskmanagerfailed = "vmware-vdiskmanager failed"
+	// defaultScsiVirtualDev is the scsi controller added when the VM has none
+	defaultScsiVirtualDev = "lsilogic"
+)
+
+var diskPresentRegexp = regexp.MustCompile(`^(nvme|scsi|sata|ide)(\d+):(\d+)\.present$`)
+var extentRegexp = regexp.MustCompile(`^(RW|RDONLY|NOACCESS)\s+(\d+)\s+\S+\s+"([^"]+)"`)
+var parentRegexp = regexp.MustCompile(`(?m)^parentFileNameHint\s*=\s*"([^"]+)"`)
+
+type VirtualDisk struct {
+	Slot       string `json:"slot"`
+	Controller string `json:"controller"`
+	Bus        int    `json:"bus"`
+	Unit       int    `json:"unit"`
+	FileName   string `json:"fileName"`
+	SizeInMb   int    `json:"sizeInMB"`
+}
+
+type CreateVirtualDisk struct {
+	Controller string `json:"controller,omitempty"`
+	SizeInMb   int    `json:"sizeInMB"`
+}
+
+func diskSlot(controller string, bus, unit int) string {
+	return fmt.Sprintf("%s%d:%d", controller, bus, unit)
+}
+
+func diskPath(vm *VirtualMachine, fileName string) string {
+	if path.IsAbs(fileName) {
+		return fileName
+	}
+
+	return path.Join(path.Dir(vm.Path), fileName)
+}
+
+// vmdkSizeInMb read the capacity from the sparse header or from the extents of a descriptor file
+func vmdkSizeInMb(vmdk string) (int, error) {
+	file, err := os.Open(vmdk)
+
+	if err != nil {
+		return 0, err
+	}
+
+	defer file.Close()
+
+	header := make([]byte, 20)
+
+	if _, err = io.ReadFull(file, header); err == nil && string(header[:4]) == sparseMagic {
+		return int(binary.LittleEndian.Uint64(header[12:20]) * sectorSize / (1024 * 1024)), nil
+	}
+
+	if _, err = file.Seek(0, io.SeekStart); err != nil {
+		return 0, err
+	}
+
+	var sectors uint64
+
+	scanner := bufio.NewScanner(file)
+
+	for scanner.Scan() {
+		if match := extentRegexp.FindStringSubmatch(strings.TrimSpace(scanner.Text())); match != nil {
+			sectors += uint64(utils.StrToInt(match[2]))
+		}
+	}
+
+	return int(sectors * sectorSize / (1024 * 1024)), nil
+}
+
+// vmdkParent return the parent disk of a delta disk like the one of a linked clone, empty for a base disk.
+// The descriptor is embedded in a sparse file, its offset and size are in the header.
+func vmdkParent(vmdk string) string {
+	content, err := os.ReadFile(vmdk)
+
+	if err != nil {
+		return ""
+	}
+
+	if len(content) >= 44 && bytes.HasPrefix(content, []byte(sparseMagic)) {
+		offset := binary.LittleEndian.Uint64(content[28:36]) * sectorSize
+		size := binary.LittleEndian.Uint64(content[36:44]) * sectorSize
+
+		if offset == 0 || offset+size > uint64(len(content)) {
+			return ""
+		}
+
+		content = content[offset : offset+size]
+	}
+
+	if match := parentRegexp.FindSubmatch(content); match != nil {
+		return string(match[1])
+	}
+
+	return ""
+}
+
+// vmdkExtents return the files used by a VMDK, the descriptor included
+func vmdkExtents(vmdk string) []string {
+	extents := []string{vmdk}
+
+	if content, err := os.ReadFile(vmdk); err == nil && !bytes.HasPrefix(content, []byte(sparseMagic)) {
+		scanner := bufio.NewScanner(bytes.NewReader(content))
+
+		for scanner.Scan() {
+			if match := extentRegexp.FindStringSubmatch(strings.TrimSpace(scanner.Text())); match != nil {
+				extents = append(extents, path.Join(path.Dir(vmdk), match[3]))
+			}
+		}
+	}
+
+	return extents
+}
+
+func (v *VmrunExe) listDisks(vm *VirtualMachine, vmx *utils.VMXMap) []*VirtualDisk {
+	disks := make([]*VirtualDisk, 0, 4)
+
+	for _, key := range vmx.Keys() {
+		if match := diskPresentRegexp.FindStringSubmatch(key); match != nil && utils.StrToBool(vmx.Get(key)) {
+			slot := diskSlot(match[1], utils.StrToInt(match[2]), utils.StrToInt(match[3]))
+			fileName := vmx.Get(slot + ".fileName")
+
+			if strings.HasPrefix(vmx.Get(slot+".deviceType"), "cdrom") || !strings.HasSuffix(strings.ToLower(fileName), ".vmdk") {
+				continue
+			}
+
+			disk := &VirtualDisk{
+				Slot:       slot,
+				Controller: match[1],
+				Bus:        utils.StrToInt(match[2]),
+				Unit:       utils.StrToInt(match[3]),
+				FileName:   fileName,
+			}
+
+			if size, err := vmdkSizeInMb(diskPath(vm, fileName)); err != nil {
+				v.logger.Warn("unable to read vmdk size", "vmdk", fileName, "error", err)
+			} else {
+				disk.SizeInMb = size
+			}
+
+			disks = append(disks, disk)
+		}
+	}
+
+	sort.Slice(disks, func(i, j int) bool {
+		return disks[i].Slot < disks[j].Slot
+	})
+
+	return disks
+}
+
+// freeDiskSlot return the first unused unit of the controller, the buses are scanned in order
+func (v *VmrunExe) freeDiskSlot(vmx *utils.VMXMap, controller string) (slot string, bus int, err error) {
+	units, found := utils.DiskControllerUnits[controller]
+
+	if !found {
+		return "", 0, status.Errorf(codes.InvalidArgument, "unsupported disk controller: %s", controller)
+	}
+
+	for bus = 0; bus < utils.DiskControllerBuses[controller]; bus++ {
+		for unit := 0; unit < units; unit++ {
+			if controller == "scsi" && unit == 7 {
+				continue
+			}
+
+			if slot = diskSlot(controller, bus, unit); !utils.StrToBool(vmx.Get(slot + ".present")) {
+				return slot, bus, nil
+			}
+		}
+	}
+
+	return "", 0, status.Errorf(codes.ResourceExhausted, "no free slot on controller: %s", controller)
+}
+
+// scsiVirtualDev return the model of the scsi bus, a new bus get the model of the first present scsi bus
+func scsiVirtualDev(vmx *utils.VMXMap, bus int) string {
+	if virtualDev := vmx.Get(fmt.Sprintf("scsi%d.virtualDev", bus)); virtualDev != "" {
+		return virtualDev
+	}
+
+	for other := 0; other < utils.DiskControllerBuses["scsi"]; other++ {
+		if prefix := fmt.Sprintf("scsi%d.", other); utils.StrToBool(vmx.Get(prefix+"present")) && vmx.Get(prefix+"virtualDev") != "" {
+			return vmx.Get(prefix + "virtualDev")
+		}
+	}
+
+	return defaultScsiVirtualDev
+}
+
+// vmdkAdapter return the vmware-vdiskmanager adapter type of a disk attached to the controller.
+// vmware-vdiskmanager only knows ide, buslogic and lsilogic, the lsilogic fallback is used for sata and nvme,
+// the adapter type is only a hint of the descriptor and VMware attach the disk to any controller.
+func vmdkAdapter(controller, virtualDev string) string {
+	if controller == "ide" {
+		return "ide"
+	} else if controller == "scsi" && virtualDev == "buslogic" {
+		return "buslogic"
+	}
+
+	return "lsilogic"
+}
+
+func (v *VmrunExe) createVMDK(vmdk string, sizeInMb int, adapter string) error {
+	cmd := exec.Command(v.exeVdiskManager, "-c", "-s", fmt.Sprintf("%dMB", sizeInMb), "-a", adapter, "-t", "0", vmdk)
+	exitCode, out := vagrant_utility.ExecuteWithOutput(cmd)
+
+	if exitCode != 0 {
+		v.logger.Debug(vdiskmanagerfailed, "exitcode", exitCode)
+		v.logger.Trace(vdiskmanagerfailed, "output", out)
+
+		return status.Errorf(codes.Internal, "failed to create VMDK: %s, reason: %s", vmdk, out)
+	}
+
+	return nil
+}
+
+func (v *VmrunExe) ListDisks(vmuuid string) ([]*VirtualDisk, error) {
+	if vm, err := v.VirtualMachineByUUID(vmuuid); err != nil {
+		return nil, status.Errorf(codes.NotFound, failedtofindvm, vmuuid, err)
+	} else if vmx, err := utils.LoadVMX(vm.Path); err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to load VMX: %s, reason: %v", vm.Path, err)
+	} else {
+		return v.listDisks(vm, vmx), nil
+	}
+}
+
+func (v *VmrunExe) AddDisk(vmuuid string, request *CreateVirtualDisk) (*VirtualDisk, error) {
+	defer v.locks.lock(vmuuid)()
+
+	if request.SizeInMb <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to add disk to VM: %s, reason: invalid size: %d", vmuuid, request.SizeInMb)
+	} else if vm, err := v.VirtualMachineByUUID(vmuuid); err != nil {
+		return nil, status.Errorf(codes.NotFound, failedtofindvm, vmuuid, err)
+	} else if vm.PowerState != PoweredOff {
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to add disk to VM: %s, reason: not powered off", vmuuid)
+	} else if vmx, err := utils.LoadVMX(vm.Path); err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to load VMX: %s, reason: %v", vm.Path, err)
+	} else {
+		controller := request.Controller
+
+		// Use the controller of the boot disk by default
+		if controller == "" {
+			if disks := v.listDisks(vm, vmx); len(disks) > 0 {
+				controller = disks[0].Controller
+			} else {
+				controller = "scsi"
+			}
+		}
+
+		slot, bus, err := v.freeDiskSlot(vmx, controller)
+
+		if err != nil {
+			return nil, err
+		}
+
+		fileName := fmt.Sprintf("%s-%s.vmdk", strings.TrimSuffix(path.Base(vm.Path), ".vmx"), strings.ReplaceAll(slot, ":", "-"))
+		vmdk := diskPath(vm, fileName)
+		virtualDev := ""
+
+		if controller == "scsi" {
+			virtualDev = scsiVirtualDev(vmx, bus)
+		}
+
+		if utils.FileExists(vmdk) {
+			return nil, status.Errorf(codes.AlreadyExists, "VMDK: %s already exists", vmdk)
+		} else if err = v.createVMDK(vmdk, request.SizeInMb, vmdkAdapter(controller, virtualDev)); err != nil {
+			return nil, err
+		}
+
+		// A new scsi bus need its model or the VM fail to power on
+		if prefix := fmt.Sprintf("%s%d.", controller, bus); !utils.StrToBool(vmx.Get(prefix + "present")) {
+			vmx.Set(prefix+"present", "TRUE")
+
+			if virtualDev != "" {
+				vmx.Set(prefix+"virtualDev", virtualDev)
+			}
+		}
+
+		vmx.Set(slot+".present", "TRUE")
+		vmx.Set(slot+".fileName", fileName)
+
+		if err = vmx.Save(vm.Path); err != nil {
+			// Don't leave an orphan VMDK
+			for _, extent := range vmdkExtents(vmdk) {
+				if err := os.Remove(extent); err != nil && !os.IsNotExist(err) {
+					v.logger.Warn("unable to delete vmdk", "vmdk", extent, "error", err)
+				}
+			}
+
+			return nil, status.Errorf(codes.FailedPrecondition, "failed to save VMX: %s, reason: %v", vm.Path, err)
+		}
+
+		for _, disk := range v.listDisks(vm, vmx) {
+			if disk.Slot == slot {
+				return disk, nil
+			}
+		}
+
+		return nil, status.Errorf(codes.Internal, "failed to find disk: %s for VM: %s", slot, vmuuid)
+	}
+}
+
+func (v *VmrunExe) RemoveDisk(vmuuid, slot string, deleteFile bool) (bool, error) {
+	defer v.locks.lock(vmuuid)()
+
+	if vm, err := v.VirtualMachineByUUID(vmuuid); err != nil {
+		return false, status.Errorf(codes.NotFound, failedtofindvm, vmuuid, err)
+	} else if vm.PowerState != PoweredOff {
+		return false, status.Errorf(codes.FailedPrecondition, "failed to remove disk from VM: %s, reason: not powered off", vmuuid)
+	} else if vmx, err := utils.LoadVMX(vm.Path); err != nil {
+		return false, status.Errorf(codes.FailedPrecondition, "failed to load VMX: %s, reason: %v", vm.Path, err)
+	} else {
+		boot, _ := bootDisk(vm.Path, vmx)
+
+		for _, disk := range v.listDisks(vm, vmx) {
+			if disk.Slot == strings.ToLower(slot) {
+				prefix := disk.Slot + "."
+				vmdk := diskPath(vm, disk.FileName)
+
+				if deleteFile && vmdk == boot {
+					return false, status.Errorf(codes.FailedPrecondition, "failed to remove disk: %s from VM: %s, reason: the boot disk can't be deleted", slot, vmuuid)
+				} else if parent := vmdkParent(vmdk); deleteFile && parent != "" {
+					return false, status.Errorf(codes.FailedPrecondition, "failed to remove disk: %s from VM: %s, reason: delta disk of: %s can't be deleted", slot, vmuuid, parent)
+				}
+
+				for _, key := range vmx.Keys() {
+					if strings.HasPrefix(key, prefix) {
+						vmx.Delete(key)
+					}
+				}
+
+				if err = vmx.Save(vm.Path); err != nil {
+					return false, status.Errorf(codes.FailedPrecondition, "failed to save VMX: %s, reason: %v", vm.Path, err)
+				}
+
+				if deleteFile {
+					for _, extent := range vmdkExtents(vmdk) {
+						if err = os.Remove(extent); err != nil && !os.IsNotExist(err) {
+							v.logger.Warn("unable to delete vmdk", "vmdk", extent, "error", err)
+						}
+					}
+				}
+
+				return true, nil
+			}
+		}
+
+		return false, status.Errorf(codes.NotFound, "failed to find disk: %s for VM: %s", slot, vmuuid)
+	}
+}
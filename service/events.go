@@ -0,0 +1,248 @@
+package service
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+)
+
+type EventType string
+
+const (
+	EventCreated      EventType = "created"
+	EventDeleted      EventType = "deleted"
+	EventPoweredOn    EventType = "poweredOn"
+	EventPoweredOff   EventType = "poweredOff"
+	EventSuspended    EventType = "suspended"
+	EventPaused       EventType = "paused"
+	EventIPAssigned   EventType = "ipAssigned"
+	EventToolsRunning EventType = "toolsRunning"
+)
+
+const (
+	eventsInterval   = 5 * time.Second
+	eventsBufferSize = 64
+)
+
+var powerStateEvents = map[PowerState]EventType{
+	PoweredOn:  EventPoweredOn,
+	PoweredOff: EventPoweredOff,
+	Suspended:  EventSuspended,
+	Paused:     EventPaused,
+}
+
+type Event struct {
+	Type       EventType  `json:"type"`
+	Time       time.Time  `json:"time"`
+	Uuid       string     `json:"uuid"`
+	Name       string     `json:"name,omitempty"`
+	PowerState PowerState `json:"powerState,omitempty"`
+	Address    string     `json:"ip4address,omitempty"`
+}
+
+type watchedVM struct {
+	name         string
+	path         string
+	powerState   PowerState
+	address      string
+	toolsRunning bool
+}
+
+// eventBus own the single watcher and fan out its events to the subscribers.
+// The watcher run while there is subscribers, stop is closed when the last one leave.
+type eventBus struct {
+	sync.Mutex
+	vmrun       *VmrunExe
+	logger      hclog.Logger
+	nextID      int
+	subscribers map[int]chan *Event
+	stop        chan struct{}
+}
+
+func newEventBus(vmrun *VmrunExe, logger hclog.Logger) *eventBus {
+	return &eventBus{
+		vmrun:       vmrun,
+		logger:      logger.Named("events"),
+		subscribers: make(map[int]chan *Event),
+	}
+}
+
+func (e *eventBus) subscribe() (<-chan *Event, func()) {
+	e.Lock()
+	defer e.Unlock()
+
+	if e.stop == nil {
+		e.stop = make(chan struct{})
+
+		go e.watch(e.stop)
+	}
+
+	id := e.nextID
+	ch := make(chan *Event, eventsBufferSize)
+
+	e.nextID++
+	e.subscribers[id] = ch
+
+	return ch, func() {
+		e.Lock()
+		defer e.Unlock()
+
+		if _, found := e.subscribers[id]; found {
+			delete(e.subscribers, id)
+			close(ch)
+
+			if len(e.subscribers) == 0 && e.stop != nil {
+				close(e.stop)
+				e.stop = nil
+			}
+		}
+	}
+}
+
+func (e *eventBus) publish(event *Event) {
+	e.Lock()
+	defer e.Unlock()
+
+	for _, ch := range e.subscribers {
+		select {
+		case ch <- event:
+		default:
+			e.logger.Warn("subscriber too slow, event dropped", "type", event.Type, "vmuuid", event.Uuid)
+		}
+	}
+}
+
+// watch scan the VMs until stop is closed, each watcher take its own baseline
+func (e *eventBus) watch(stop <-chan struct{}) {
+	var known map[string]*watchedVM
+	var err error
+
+	ticker := time.NewTicker(eventsInterval)
+	defer ticker.Stop()
+
+	for {
+		if known, err = e.scan(known); err != nil {
+			e.logger.Debug("unable to scan VMs", "error", err)
+		}
+
+		select {
+		case <-stop:
+			e.logger.Debug("no more subscribers, stop watching")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// scan diff the current state of all VMs with the known one and return the new state, nil known only take a baseline
+func (e *eventBus) scan(known map[string]*watchedVM) (map[string]*watchedVM, error) {
+	v := e.vmrun
+
+	vms, err := v.client.GetAllVMs()
+
+	if err != nil {
+		return known, err
+	}
+
+	running, err := v.runningVMX()
+
+	if err != nil {
+		return known, err
+	}
+
+	baseline := known == nil
+	current := make(map[string]*watchedVM, len(vms))
+	events := make([]*Event, 0)
+
+	for _, info := range vms {
+		previous, found := known[info.Id]
+		watched := &watchedVM{
+			path: info.Path,
+		}
+
+		if found {
+			watched.name = previous.name
+		} else if name, err := v.client.GetVMParams(info.Id, vmnameKey); err == nil {
+			watched.name = name.Value
+		}
+
+		vm := &VirtualMachine{
+			Uuid: info.Id,
+			Path: info.Path,
+			Name: watched.name,
+		}
+
+		if watched.powerState, err = v.powerStateOf(vm, running[info.Path]); err != nil {
+			continue
+		}
+
+		if watched.powerState == PoweredOn {
+			if ip, err := v.fetchIPAddress(info.Id); err == nil && ip != nil {
+				watched.address = ip.Ip
+			}
+
+			if found && previous.toolsRunning {
+				watched.toolsRunning = true
+			} else {
+				v.vmwareToolsStatus(vm)
+				watched.toolsRunning = strings.HasPrefix(vm.ToolsStatus, "running")
+			}
+		}
+
+		current[info.Id] = watched
+
+		newEvent := func(eventType EventType) *Event {
+			return &Event{
+				Type:       eventType,
+				Time:       time.Now(),
+				Uuid:       info.Id,
+				Name:       watched.name,
+				PowerState: watched.powerState,
+				Address:    watched.address,
+			}
+		}
+
+		if baseline {
+			continue
+		} else if !found {
+			events = append(events, newEvent(EventCreated))
+			previous = &watchedVM{powerState: PoweredOff}
+		}
+
+		if watched.powerState != previous.powerState {
+			events = append(events, newEvent(powerStateEvents[watched.powerState]))
+		}
+
+		if watched.address != "" && watched.address != previous.address {
+			events = append(events, newEvent(EventIPAssigned))
+		}
+
+		if watched.toolsRunning && !previous.toolsRunning {
+			events = append(events, newEvent(EventToolsRunning))
+		}
+	}
+
+	for vmuuid, previous := range known {
+		if _, found := current[vmuuid]; !found {
+			events = append(events, &Event{
+				Type: EventDeleted,
+				Time: time.Now(),
+				Uuid: vmuuid,
+				Name: previous.name,
+			})
+		}
+	}
+
+	for _, event := range events {
+		e.publish(event)
+	}
+
+	return current, nil
+}
+
+// Subscribe return a channel receiving VM events and the function to call to unsubscribe
+func (v *VmrunExe) Subscribe() (<-chan *Event, func()) {
+	return v.events.subscribe()
+}
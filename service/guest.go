@@ -0,0 +1,131 @@
+package service
+
+import (
+	"os/exec"
+	"regexp"
+	"strings"
+
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/status"
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/utils"
+	vagrant_utility "github.com/hashicorp/vagrant-vmware-desktop/go_src/vagrant-vmware-utility/utility"
+	codes "google.golang.org/grpc/codes"
+)
+
+var guestExitCodeRegexp = regexp.MustCompile(`exit code:\s*(\d+)`)
+var guestProcessRegexp = regexp.MustCompile(`^pid=(\d+), owner=(.*), cmd=(.*)$`)
+
+type GuestCredentials struct {
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+}
+
+type GuestProcess struct {
+	Pid     int    `json:"pid"`
+	Owner   string `json:"owner,omitempty"`
+	Command string `json:"cmd,omitempty"`
+}
+
+// runInGuest execute a vmrun guest command, credentials are never logged
+func (v *VmrunExe) runInGuest(vmuuid string, creds *GuestCredentials, command string, args ...string) (string, error) {
+	if creds == nil || creds.Username == "" {
+		return "", status.Errorf(codes.InvalidArgument, "failed to %s for VM: %s, reason: guest credentials are missing", command, vmuuid)
+	} else if vm, err := v.VirtualMachineByUUID(vmuuid); err != nil {
+		return "", status.Errorf(codes.NotFound, failedtofindvm, vmuuid, err)
+	} else if vm.PowerState != PoweredOn {
+		return "", status.Errorf(codes.FailedPrecondition, "failed to %s for VM: %s, reason: not running", command, vmuuid)
+	} else {
+		cmd := exec.Command(v.exePath, append([]string{"-gu", creds.Username, "-gp", creds.Password, command, vm.Path}, args...)...)
+		exitCode, out := vagrant_utility.ExecuteWithOutput(cmd)
+
+		if exitCode != 0 {
+			// Non zero exit code of the guest program is not an error of vmrun
+			if guestExitCodeRegexp.MatchString(out) {
+				return out, nil
+			}
+
+			v.logger.Debug("vmrun "+command+" failed", "exitcode", exitCode)
+			v.logger.Trace("vmrun "+command+" failed", "output", out)
+
+			return out, status.Errorf(codes.Internal, "failed to %s for VM: %s, reason: %s", command, vmuuid, out)
+		}
+
+		return out, nil
+	}
+}
+
+func guestExitCode(out string) int {
+	if match := guestExitCodeRegexp.FindStringSubmatch(out); match != nil {
+		return utils.StrToInt(match[1])
+	}
+
+	return 0
+}
+
+func (v *VmrunExe) RunProgramInGuest(vmuuid string, creds *GuestCredentials, program string, args []string, noWait bool) (int, error) {
+	options := make([]string, 0, len(args)+2)
+
+	if noWait {
+		options = append(options, "-noWait")
+	}
+
+	options = append(options, program)
+	options = append(options, args...)
+
+	if out, err := v.runInGuest(vmuuid, creds, "runProgramInGuest", options...); err != nil {
+		return -1, err
+	} else {
+		return guestExitCode(out), nil
+	}
+}
+
+func (v *VmrunExe) RunScriptInGuest(vmuuid string, creds *GuestCredentials, interpreter, script string, noWait bool) (int, error) {
+	options := make([]string, 0, 3)
+
+	if noWait {
+		options = append(options, "-noWait")
+	}
+
+	options = append(options, interpreter, script)
+
+	if out, err := v.runInGuest(vmuuid, creds, "runScriptInGuest", options...); err != nil {
+		return -1, err
+	} else {
+		return guestExitCode(out), nil
+	}
+}
+
+func (v *VmrunExe) CopyFileFromHostToGuest(vmuuid string, creds *GuestCredentials, hostPath, guestPath string) (bool, error) {
+	if _, err := v.runInGuest(vmuuid, creds, "copyFileFromHostToGuest", hostPath, guestPath); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (v *VmrunExe) CopyFileFromGuestToHost(vmuuid string, creds *GuestCredentials, guestPath, hostPath string) (bool, error) {
+	if _, err := v.runInGuest(vmuuid, creds, "copyFileFromGuestToHost", guestPath, hostPath); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (v *VmrunExe) ListProcessesInGuest(vmuuid string, creds *GuestCredentials) ([]*GuestProcess, error) {
+	if out, err := v.runInGuest(vmuuid, creds, "listProcessesInGuest"); err != nil {
+		return nil, err
+	} else {
+		processes := make([]*GuestProcess, 0)
+
+		for _, line := range strings.Split(out, "\n") {
+			if match := guestProcessRegexp.FindStringSubmatch(strings.TrimSpace(line)); match != nil {
+				processes = append(processes, &GuestProcess{
+					Pid:     utils.StrToInt(match[1]),
+					Owner:   match[2],
+					Command: match[3],
+				})
+			}
+		}
+
+		return processes, nil
+	}
+}
@@ -0,0 +1,125 @@
+package service
+
+import (
+	"os/exec"
+	"strings"
+
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/status"
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/utils"
+	vagrant_utility "github.com/hashicorp/vagrant-vmware-desktop/go_src/vagrant-vmware-utility/utility"
+	codes "google.golang.org/grpc/codes"
+)
+
+// guestInfoName strip the guestinfo prefix, guestVar are seen as guestinfo.<name> inside the guest
+func guestInfoName(key string) string {
+	if strings.HasPrefix(strings.ToLower(key), guestinfoKey) {
+		return key[len(guestinfoKey):]
+	}
+
+	return key
+}
+
+func (v *VmrunExe) readVariable(vm *VirtualMachine, name string) (string, error) {
+	cmd := exec.Command(v.exePath, "readVariable", vm.Path, "guestVar", name)
+	exitCode, out := vagrant_utility.ExecuteWithOutput(cmd)
+
+	if exitCode != 0 {
+		v.logger.Debug("vmrun readVariable failed", "exitcode", exitCode)
+		v.logger.Trace("vmrun readVariable failed", "output", out)
+
+		return "", status.Errorf(codes.Internal, "failed to read guestinfo: %s for VM: %s, reason: %s", name, vm.Uuid, out)
+	}
+
+	return strings.TrimRight(out, "\r\n"), nil
+}
+
+func (v *VmrunExe) writeVariable(vm *VirtualMachine, variable, name, value string) error {
+	cmd := exec.Command(v.exePath, "writeVariable", vm.Path, variable, name, value)
+	exitCode, out := vagrant_utility.ExecuteWithOutput(cmd)
+
+	if exitCode != 0 {
+		v.logger.Debug("vmrun writeVariable failed", "exitcode", exitCode)
+		v.logger.Trace("vmrun writeVariable failed", "output", out)
+
+		return status.Errorf(codes.Internal, "failed to write guestinfo: %s for VM: %s, reason: %s", name, vm.Uuid, out)
+	}
+
+	return nil
+}
+
+func (v *VmrunExe) GetGuestInfo(vmuuid, key string) (string, error) {
+	name := guestInfoName(key)
+
+	if name == "" {
+		return "", status.Error(codes.InvalidArgument, "guestinfo name is empty")
+	} else if vm, err := v.VirtualMachineByUUID(vmuuid); err != nil {
+		return "", status.Errorf(codes.NotFound, failedtofindvm, vmuuid, err)
+	} else if vm.PowerState.Powered() {
+		return v.readVariable(vm, name)
+	} else if vmx, err := utils.LoadVMX(vm.Path); err != nil {
+		return "", status.Errorf(codes.FailedPrecondition, "failed to load VMX: %s, reason: %v", vm.Path, err)
+	} else if !vmx.Has(guestinfoKey + name) {
+		return "", status.Errorf(codes.NotFound, "guestinfo: %s not found for VM: %s", name, vmuuid)
+	} else {
+		return vmx.Get(guestinfoKey + name), nil
+	}
+}
+
+func (v *VmrunExe) SetGuestInfo(vmuuid, key, value string) (bool, error) {
+	defer v.locks.lock(vmuuid)()
+
+	name := guestInfoName(key)
+
+	if name == "" {
+		return false, status.Error(codes.InvalidArgument, "guestinfo name is empty")
+	} else if vm, err := v.VirtualMachineByUUID(vmuuid); err != nil {
+		return false, status.Errorf(codes.NotFound, failedtofindvm, vmuuid, err)
+	} else if vm.PowerState == Suspended {
+		return false, status.Errorf(codes.FailedPrecondition, "failed to set guestinfo: %s for VM: %s, reason: suspended", name, vmuuid)
+	} else if vm.PowerState.Powered() {
+		// guestVar is seen immediately by the guest, runtimeConfig keep it in the VMX for the next boot
+		if err = v.writeVariable(vm, "guestVar", name, value); err != nil {
+			return false, err
+		} else if err = v.writeVariable(vm, "runtimeConfig", guestinfoKey+name, value); err != nil {
+			return false, err
+		}
+	} else if vmx, err := utils.LoadVMX(vm.Path); err != nil {
+		return false, status.Errorf(codes.FailedPrecondition, "failed to load VMX: %s, reason: %v", vm.Path, err)
+	} else {
+		vmx.Set(guestinfoKey+name, value)
+
+		if err = vmx.Save(vm.Path); err != nil {
+			return false, status.Errorf(codes.FailedPrecondition, "failed to save VMX: %s, reason: %v", vm.Path, err)
+		}
+	}
+
+	return true, nil
+}
+
+func (v *VmrunExe) ListGuestInfos(vmuuid string) (map[string]string, error) {
+	if vm, err := v.VirtualMachineByUUID(vmuuid); err != nil {
+		return nil, status.Errorf(codes.NotFound, failedtofindvm, vmuuid, err)
+	} else if vmx, err := utils.LoadVMX(vm.Path); err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to load VMX: %s, reason: %v", vm.Path, err)
+	} else {
+		result := make(map[string]string)
+
+		for _, key := range vmx.Keys() {
+			if strings.HasPrefix(key, guestinfoKey) {
+				name := guestInfoName(key)
+				value := vmx.Get(key)
+
+				// Runtime value could differ from the one stored in the VMX
+				if vm.PowerState.Powered() {
+					if current, err := v.readVariable(vm, name); err == nil {
+						value = current
+					}
+				}
+
+				result[name] = value
+			}
+		}
+
+		return result, nil
+	}
+}
@@ -0,0 +1,373 @@
+package service
+
+import (
+	"encoding/binary"
+	"net"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"sync"
+
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/status"
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/utility"
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/utils"
+	"github.com/hashicorp/go-hclog"
+	codes "google.golang.org/grpc/codes"
+)
+
+const ipamFile = "ipam.json"
+
+var dhcpSubnetRegexp = regexp.MustCompile(`subnet\s+(\S+)\s+netmask\s+(\S+)\s*\{([^}]*)\}`)
+var dhcpRangeRegexp = regexp.MustCompile(`range\s+(\S+)\s+(\S+)\s*;`)
+
+// IPAllocation is a static address handed out on a vmnet, the owner is the name of the VM
+type IPAllocation struct {
+	Vmnet   string `json:"vmnet,omitempty"`
+	Address string `json:"address,omitempty"`
+	Owner   string `json:"owner,omitempty"`
+}
+
+// ipam track the static addresses allocated on the subnet of each vmnet.
+// Allocations are persisted in the config directory and reclaimed when the owner VM is deleted.
+type ipam struct {
+	sync.Mutex
+	once        sync.Once
+	vmrun       *VmrunExe
+	logger      hclog.Logger
+	path        string
+	allocations map[string]map[string]string
+}
+
+func newIPAM(vmrun *VmrunExe, folder string, logger hclog.Logger) *ipam {
+	m := &ipam{
+		vmrun:       vmrun,
+		logger:      logger.Named("ipam"),
+		allocations: make(map[string]map[string]string),
+	}
+
+	if folder != "" {
+		m.path = filepath.Join(folder, ipamFile)
+	}
+
+	return m
+}
+
+func ipToUint32(ip net.IP) uint32 {
+	if ip = ip.To4(); ip == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint32(ip)
+}
+
+func uint32ToIP(value uint32) net.IP {
+	ip := make(net.IP, net.IPv4len)
+
+	binary.BigEndian.PutUint32(ip, value)
+
+	return ip
+}
+
+// parseSubnet return the IPv4 network of a vmnet, nil if the vmnet has no subnet
+func parseSubnet(subnet, mask string) *net.IPNet {
+	ip := net.ParseIP(subnet).To4()
+	ipmask := net.ParseIP(mask).To4()
+
+	if ip == nil || ipmask == nil {
+		return nil
+	}
+
+	return &net.IPNet{IP: ip.Mask(net.IPMask(ipmask)), Mask: net.IPMask(ipmask)}
+}
+
+// hostRange return the first and the last host address of the network
+func hostRange(ipnet *net.IPNet) (uint32, uint32) {
+	first := ipToUint32(ipnet.IP)
+	last := first | ^binary.BigEndian.Uint32(ipnet.Mask)
+
+	return first + 1, last - 1
+}
+
+// dhcpRange return the dynamic pool of the DHCP server of the vmnet as declared by its configuration.
+// Without configuration for the subnet, the VMware default is assumed: the upper half of the subnet.
+func dhcpRange(vmnet string, ipnet *net.IPNet) (uint32, uint32) {
+	if content, err := os.ReadFile(utility.DhcpConfig(vmnet)); err == nil {
+		for _, match := range dhcpSubnetRegexp.FindAllStringSubmatch(string(content), -1) {
+			if subnet := parseSubnet(match[1], match[2]); subnet != nil && subnet.String() == ipnet.String() {
+				if bounds := dhcpRangeRegexp.FindStringSubmatch(match[3]); bounds != nil {
+					if first, last := net.ParseIP(bounds[1]).To4(), net.ParseIP(bounds[2]).To4(); first != nil && last != nil {
+						return ipToUint32(first), ipToUint32(last)
+					}
+				}
+			}
+		}
+	}
+
+	first, last := hostRange(ipnet)
+
+	return first + (last-first+1)/2, last
+}
+
+func (m *ipam) load() {
+	if m.path == "" {
+		m.path = utility.DirectoryForConfig(ipamFile)
+	}
+
+	if utils.FileExists(m.path) {
+		if err := utils.LoadJsonFromFile(m.path, &m.allocations); err != nil {
+			m.logger.Warn("unable to load ip allocations", "path", m.path, "error", err)
+		}
+	}
+}
+
+func (m *ipam) save() error {
+	return utils.StoreJsonToFile(m.path, m.allocations)
+}
+
+// network return the vmnet and its subnet as reported by ListNetworks
+func (m *ipam) network(vmnet string) (*NetworkDevice, *net.IPNet, error) {
+	if networks, err := m.vmrun.ListNetworks(); err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to list networks, reason: %v", err)
+	} else {
+		for _, network := range networks {
+			if network.Name == vmnet {
+				if ipnet := parseSubnet(network.Subnet, network.Mask); ipnet == nil {
+					return nil, nil, status.Errorf(codes.FailedPrecondition, "vmnet: %s, has no subnet", vmnet)
+				} else if ones, bits := ipnet.Mask.Size(); bits-ones < 2 {
+					return nil, nil, status.Errorf(codes.FailedPrecondition, "subnet: %s of vmnet: %s, is too small", ipnet, vmnet)
+				} else {
+					return network, ipnet, nil
+				}
+			}
+		}
+
+		return nil, nil, status.Errorf(codes.NotFound, "vmnet: %s, not found", vmnet)
+	}
+}
+
+// used return the addresses not available on the vmnet: the host adapter, the NAT gateway,
+// the DHCP server, its dynamic pool and its reservations
+func (m *ipam) used(network *NetworkDevice, ipnet *net.IPNet) map[string]string {
+	first, last := hostRange(ipnet)
+	used := map[string]string{
+		uint32ToIP(first).String(): "host",
+	}
+
+	if network.Type == "nat" {
+		used[uint32ToIP(first+1).String()] = "gateway"
+	}
+
+	if network.Dhcp {
+		// VMware give the last host address to the DHCP server
+		used[uint32ToIP(last).String()] = "dhcp-server"
+
+		poolFirst, poolLast := dhcpRange(network.Name, ipnet)
+
+		for address := poolFirst; address <= poolLast && address != 0; address++ {
+			if _, found := used[uint32ToIP(address).String()]; !found {
+				used[uint32ToIP(address).String()] = "dhcp-pool"
+			}
+		}
+
+		if mactoips, err := m.vmrun.client.GetMACToIPs(network.Name); err == nil {
+			for _, mactoip := range mactoips.Mactoips {
+				used[mactoip.Ip] = "dhcp:" + mactoip.Mac
+			}
+		}
+	}
+
+	return used
+}
+
+// allocate hand out the address to the owner, the first free address of the subnet if address is empty
+func (m *ipam) allocate(vmnet, owner, address string) (*IPAllocation, error) {
+	m.once.Do(m.load)
+
+	m.Lock()
+	defer m.Unlock()
+
+	if owner == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "an owner is required to allocate an address on vmnet: %s", vmnet)
+	}
+
+	network, ipnet, err := m.network(vmnet)
+
+	if err != nil {
+		return nil, err
+	}
+
+	allocated := m.allocations[vmnet]
+	used := m.used(network, ipnet)
+	first, last := hostRange(ipnet)
+
+	if address != "" {
+		ip := net.ParseIP(address).To4()
+
+		if ip == nil || !ipnet.Contains(ip) || ipToUint32(ip) < first || ipToUint32(ip) > last {
+			return nil, status.Errorf(codes.InvalidArgument, "address: %s is not an host address of the subnet: %s of vmnet: %s", address, ipnet, vmnet)
+		}
+
+		address = ip.String()
+
+		if other, found := allocated[address]; found && other == owner {
+			return &IPAllocation{Vmnet: vmnet, Address: address, Owner: owner}, nil
+		} else if found {
+			return nil, status.Errorf(codes.AlreadyExists, "address: %s on vmnet: %s, already allocated to: %s", address, vmnet, other)
+		} else if other, found := used[address]; found {
+			return nil, status.Errorf(codes.AlreadyExists, "address: %s on vmnet: %s, already used by: %s", address, vmnet, other)
+		}
+	} else {
+		for candidate := first; candidate <= last && address == ""; candidate++ {
+			ip := uint32ToIP(candidate).String()
+
+			if _, found := allocated[ip]; !found {
+				if _, found = used[ip]; !found {
+					address = ip
+				}
+			}
+		}
+
+		if address == "" {
+			return nil, status.Errorf(codes.ResourceExhausted, "no free address on the subnet: %s of vmnet: %s", ipnet, vmnet)
+		}
+	}
+
+	if allocated == nil {
+		allocated = make(map[string]string)
+		m.allocations[vmnet] = allocated
+	}
+
+	allocated[address] = owner
+
+	if err = m.save(); err != nil {
+		delete(allocated, address)
+
+		return nil, status.Errorf(codes.Internal, "failed to save ip allocations: %s, reason: %v", m.path, err)
+	}
+
+	m.logger.Info("address allocated", "vmnet", vmnet, "address", address, "owner", owner)
+
+	return &IPAllocation{Vmnet: vmnet, Address: address, Owner: owner}, nil
+}
+
+// owner return the VM the address is allocated to
+func (m *ipam) owner(vmnet, address string) (string, bool) {
+	m.once.Do(m.load)
+
+	m.Lock()
+	defer m.Unlock()
+
+	if ip := net.ParseIP(address).To4(); ip != nil {
+		address = ip.String()
+	}
+
+	owner, found := m.allocations[vmnet][address]
+
+	return owner, found
+}
+
+// release give back an allocated address
+func (m *ipam) release(vmnet, address string) (bool, error) {
+	m.once.Do(m.load)
+
+	m.Lock()
+	defer m.Unlock()
+
+	if ip := net.ParseIP(address).To4(); ip != nil {
+		address = ip.String()
+	}
+
+	if owner, found := m.allocations[vmnet][address]; !found {
+		return false, status.Errorf(codes.NotFound, "address: %s on vmnet: %s, not allocated", address, vmnet)
+	} else {
+		delete(m.allocations[vmnet], address)
+
+		if err := m.save(); err != nil {
+			m.allocations[vmnet][address] = owner
+
+			return false, status.Errorf(codes.Internal, "failed to save ip allocations: %s, reason: %v", m.path, err)
+		}
+
+		m.logger.Info("address released", "vmnet", vmnet, "address", address, "owner", owner)
+
+		return true, nil
+	}
+}
+
+// reclaim release all the addresses of a deleted VM
+func (m *ipam) reclaim(owner string) {
+	m.once.Do(m.load)
+
+	m.Lock()
+	defer m.Unlock()
+
+	reclaimed := 0
+
+	for vmnet, allocated := range m.allocations {
+		for address, other := range allocated {
+			if other == owner {
+				delete(allocated, address)
+				reclaimed++
+
+				m.logger.Debug("address reclaimed", "vmnet", vmnet, "address", address, "owner", owner)
+			}
+		}
+	}
+
+	if reclaimed > 0 {
+		if err := m.save(); err != nil {
+			m.logger.Error("unable to save ip allocations", "path", m.path, "error", err)
+		}
+	}
+}
+
+// list return the allocations of the vmnet or of all the vmnets if vmnet is empty, ordered by vmnet and address
+func (m *ipam) list(vmnet string) []*IPAllocation {
+	m.once.Do(m.load)
+
+	m.Lock()
+	defer m.Unlock()
+
+	result := make([]*IPAllocation, 0)
+
+	for name, allocated := range m.allocations {
+		if vmnet == "" || name == vmnet {
+			for address, owner := range allocated {
+				result = append(result, &IPAllocation{Vmnet: name, Address: address, Owner: owner})
+			}
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Vmnet != result[j].Vmnet {
+			return result[i].Vmnet < result[j].Vmnet
+		}
+
+		return ipToUint32(net.ParseIP(result[i].Address)) < ipToUint32(net.ParseIP(result[j].Address))
+	})
+
+	return result
+}
+
+// ListIPAllocations return the static addresses allocated on the vmnet, all the vmnets if empty
+func (v *VmrunExe) ListIPAllocations(vmnet string) ([]*IPAllocation, error) {
+	if vmnet != "" {
+		if _, _, err := v.ipam.network(vmnet); err != nil {
+			return nil, err
+		}
+	}
+
+	return v.ipam.list(vmnet), nil
+}
+
+// AllocateIP hand out a static address of the vmnet subnet to the VM named owner.
+// The given address is checked, otherwise the first free one is returned.
+func (v *VmrunExe) AllocateIP(vmnet, owner, address string) (*IPAllocation, error) {
+	return v.ipam.allocate(vmnet, owner, address)
+}
+
+// ReleaseIP give back a static address of the vmnet
+func (v *VmrunExe) ReleaseIP(vmnet, address string) (bool, error) {
+	return v.ipam.release(vmnet, address)
+}
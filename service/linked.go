@@ -0,0 +1,50 @@
+package service
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/status"
+	codes "google.golang.org/grpc/codes"
+)
+
+const (
+	linkedSnapshotName        = "autoscaler-linked-base"
+	linkedSnapshotDescription = "Base snapshot for linked clones, do not delete"
+)
+
+// linkedSnapshot return the name of the template snapshot used as base for linked clones, creating it if needed
+func (v *VmrunExe) linkedSnapshot(template *VirtualMachine) (string, error) {
+	if _, err := v.findSnapshot(template, linkedSnapshotName); err == nil {
+		return linkedSnapshotName, nil
+	} else if _, err = v.takeSnapshot(template, linkedSnapshotName, linkedSnapshotDescription); err != nil {
+		return "", err
+	}
+
+	return linkedSnapshotName, nil
+}
+
+// linkedChildren return the vmx path of every existing linked clone of the VM
+func (v *VmrunExe) linkedChildren(vm *VirtualMachine) ([]string, error) {
+	entries, _, err := v.loadSnapshots(vm)
+
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, failedtoloadsnapshots, vm.Uuid, err)
+	}
+
+	children := make([]string, 0)
+
+	for _, entry := range entries {
+		for _, vmxpath := range entry.clones {
+			if !filepath.IsAbs(vmxpath) {
+				vmxpath = filepath.Join(filepath.Dir(vm.Path), vmxpath)
+			}
+
+			if _, err := os.Stat(vmxpath); err == nil {
+				children = append(children, vmxpath)
+			}
+		}
+	}
+
+	return children, nil
+}
@@ -0,0 +1,185 @@
+package service
+
+import (
+	"sync"
+
+	"github.com/Fred78290/vmrest-go-client/client"
+	"github.com/Fred78290/vmrest-go-client/client/api"
+)
+
+type vmLock struct {
+	sync.RWMutex
+	refs int
+}
+
+// vmLocks serialize the operations on the same VM while letting independent VMs progress concurrently
+type vmLocks struct {
+	sync.Mutex
+	locks map[string]*vmLock
+}
+
+func newVMLocks() *vmLocks {
+	return &vmLocks{
+		locks: make(map[string]*vmLock),
+	}
+}
+
+func nameLockKey(name string) string {
+	return "name:" + name
+}
+
+// vmnetLockKey is the key of a vmnet, the empty name is the key of the vmnets inventory
+func vmnetLockKey(name string) string {
+	return "vmnet:" + name
+}
+
+func (l *vmLocks) acquire(key string) *vmLock {
+	l.Lock()
+	defer l.Unlock()
+
+	lock, found := l.locks[key]
+
+	if !found {
+		lock = &vmLock{}
+		l.locks[key] = lock
+	}
+
+	lock.refs++
+
+	return lock
+}
+
+func (l *vmLocks) release(key string, lock *vmLock) {
+	l.Lock()
+	defer l.Unlock()
+
+	if lock.refs--; lock.refs == 0 {
+		delete(l.locks, key)
+	}
+}
+
+// lock take the exclusive lock of a key and return the function to release it
+func (l *vmLocks) lock(key string) func() {
+	lock := l.acquire(key)
+
+	lock.Lock()
+
+	return func() {
+		lock.Unlock()
+		l.release(key, lock)
+	}
+}
+
+// rlock take the shared lock of a key and return the function to release it
+func (l *vmLocks) rlock(key string) func() {
+	lock := l.acquire(key)
+
+	lock.RLock()
+
+	return func() {
+		lock.RUnlock()
+		l.release(key, lock)
+	}
+}
+
+// LockVM take the exclusive lock of a VM shared with the service operations, for the changes made outside of the service
+func (v *VmrunExe) LockVM(vmuuid string) func() {
+	return v.locks.lock(vmuuid)
+}
+
+// RLockVM take the shared lock of a VM
+func (v *VmrunExe) RLockVM(vmuuid string) func() {
+	return v.locks.rlock(vmuuid)
+}
+
+// LockVmnet take the exclusive lock of a vmnet and the shared lock of the vmnets inventory.
+// The empty name take the exclusive lock of the inventory, it's needed to create a vmnet or to change its subnet.
+func (v *VmrunExe) LockVmnet(name string) func() {
+	if name == "" {
+		return v.locks.lock(vmnetLockKey(""))
+	}
+
+	unlockInventory := v.locks.rlock(vmnetLockKey(""))
+	unlock := v.locks.lock(vmnetLockKey(name))
+
+	return func() {
+		unlock()
+		unlockInventory()
+	}
+}
+
+// RLockVmnet take the shared lock of a vmnet and of the vmnets inventory, the empty name lock only the inventory
+func (v *VmrunExe) RLockVmnet(name string) func() {
+	unlockInventory := v.locks.rlock(vmnetLockKey(""))
+
+	if name == "" {
+		return unlockInventory
+	}
+
+	unlock := v.locks.rlock(vmnetLockKey(name))
+
+	return func() {
+		unlock()
+		unlockInventory()
+	}
+}
+
+// serializedClient serialize the calls to vmrest, the http wrapper of the client isn't safe for concurrent use
+type serializedClient struct {
+	sync.Mutex
+	client api.Client
+}
+
+func (c *serializedClient) Patch(path string, body interface{}, result interface{}) error {
+	c.Lock()
+	defer c.Unlock()
+
+	return c.client.Patch(path, body, result)
+}
+
+func (c *serializedClient) Post(path string, body interface{}, result interface{}) error {
+	c.Lock()
+	defer c.Unlock()
+
+	return c.client.Post(path, body, result)
+}
+
+func (c *serializedClient) Put(path string, body interface{}, result interface{}) error {
+	c.Lock()
+	defer c.Unlock()
+
+	return c.client.Put(path, body, result)
+}
+
+func (c *serializedClient) Get(path string, result interface{}) error {
+	c.Lock()
+	defer c.Unlock()
+
+	return c.client.Get(path, result)
+}
+
+func (c *serializedClient) Delete(path string, result interface{}) error {
+	c.Lock()
+	defer c.Unlock()
+
+	return c.client.Delete(path, result)
+}
+
+// vmrestClient build the vmrest services once, client.APIClient create them lazily without synchronization
+type vmrestClient struct {
+	api.HostNetworksManagementApiService
+	api.ManagementApiService
+	api.NetworkAdaptersManagementApiService
+	api.PowerManagementApiService
+}
+
+func newVmrestClient(c *client.APIClient) *vmrestClient {
+	serialized := &serializedClient{client: c.Client}
+
+	return &vmrestClient{
+		HostNetworksManagementApiService:    api.NewHostNetworksManagementApiService(serialized),
+		ManagementApiService:                api.NewManagementApiService(serialized),
+		NetworkAdaptersManagementApiService: api.NewNetworkAdaptersManagementApiService(serialized),
+		PowerManagementApiService:           api.NewPowerManagementApiService(serialized),
+	}
+}
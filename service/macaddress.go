@@ -0,0 +1,208 @@
+package service
+
+import (
+	"fmt"
+	"hash/fnv"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/status"
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/utility"
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/utils"
+	"github.com/hashicorp/go-hclog"
+	codes "google.golang.org/grpc/codes"
+)
+
+const (
+	// MacAddressGenerated let VMware generate the MAC address
+	MacAddressGenerated = "generated"
+	// MacAddressStatic ask the allocator for a static MAC address
+	MacAddressStatic = "static"
+
+	macReservationsFile = "macaddresses.json"
+	// The static range of VMware is 00:50:56:00:00:00 to 00:50:56:3F:FF:FF
+	vmwareStaticOUI   = "00:50:56"
+	vmwareStaticRange = 0x400000
+)
+
+// macAllocator hand out static MAC addresses derived from the VM name and the NIC index.
+// Reservations are persisted in the config directory so a VM recreated with the same name get the same addresses.
+type macAllocator struct {
+	sync.Mutex
+	once         sync.Once
+	vmrun        *VmrunExe
+	logger       hclog.Logger
+	path         string
+	reservations map[string]string
+}
+
+func newMacAllocator(vmrun *VmrunExe, folder string, logger hclog.Logger) *macAllocator {
+	m := &macAllocator{
+		vmrun:        vmrun,
+		logger:       logger.Named("mac"),
+		reservations: make(map[string]string),
+	}
+
+	if folder != "" {
+		m.path = filepath.Join(folder, macReservationsFile)
+	}
+
+	return m
+}
+
+func macReservationKey(vmname string, card int) string {
+	return fmt.Sprintf("%s/%d", vmname, card)
+}
+
+func staticMacAddress(offset uint32) string {
+	offset %= vmwareStaticRange
+
+	return fmt.Sprintf("%s:%02x:%02x:%02x", vmwareStaticOUI, (offset>>16)&0xff, (offset>>8)&0xff, offset&0xff)
+}
+
+// derivedOffset is the first candidate in the static range for the NIC of the VM
+func derivedOffset(vmname string, card int) uint32 {
+	hash := fnv.New32a()
+
+	hash.Write([]byte(macReservationKey(vmname, card)))
+
+	return hash.Sum32() % vmwareStaticRange
+}
+
+func (m *macAllocator) load() {
+	if m.path == "" {
+		m.path = utility.DirectoryForConfig(macReservationsFile)
+	}
+
+	if utils.FileExists(m.path) {
+		if err := utils.LoadJsonFromFile(m.path, &m.reservations); err != nil {
+			m.logger.Warn("unable to load mac reservations", "path", m.path, "error", err)
+		}
+	}
+}
+
+func (m *macAllocator) save() error {
+	return utils.StoreJsonToFile(m.path, m.reservations)
+}
+
+// usedMacAddresses collect the MAC addresses of every registered VM, powered or not, the owner of each address is the VM name.
+// The cache only hold the VMs recently looked up, so the inventory is listed again.
+func (m *macAllocator) usedMacAddresses() (map[string]string, error) {
+	used := make(map[string]string)
+
+	if vms, err := m.vmrun.ListVirtualMachines(); err != nil {
+		return nil, err
+	} else {
+		for _, vm := range vms {
+			if vmx, err := utils.LoadVMX(vm.Path); err != nil {
+				m.logger.Debug("unable to scan vmx", "path", vm.Path, "error", err)
+			} else {
+				for _, ethernet := range utils.ReadEthernets(vmx) {
+					if mac := strings.ToLower(ethernet.MacAddress()); mac != "" {
+						used[mac] = vm.Name
+					}
+				}
+			}
+		}
+	}
+
+	return used, nil
+}
+
+// check verify a caller supplied MAC address isn't used by another VM
+func (m *macAllocator) check(vmname, mac string) error {
+	if used, err := m.usedMacAddresses(); err != nil {
+		return status.Errorf(codes.Internal, "failed to scan MAC addresses, reason: %v", err)
+	} else if owner, found := used[strings.ToLower(mac)]; found && owner != vmname {
+		return status.Errorf(codes.AlreadyExists, "MAC address: %s, already used by VM: %s", mac, owner)
+	}
+
+	return nil
+}
+
+// allocate return the static MAC address of the NIC, the reservation is persisted if reserve is true
+func (m *macAllocator) allocate(vmname string, card int, reserve bool) (string, error) {
+	m.once.Do(m.load)
+
+	m.Lock()
+	defer m.Unlock()
+
+	key := macReservationKey(vmname, card)
+	used, err := m.usedMacAddresses()
+
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to scan MAC addresses, reason: %v", err)
+	}
+
+	// Addresses reserved for other NICs are taken even if their VM doesn't exist anymore
+	for other, mac := range m.reservations {
+		if other != key {
+			if _, found := used[mac]; !found {
+				used[mac] = other
+			}
+		}
+	}
+
+	if mac, found := m.reservations[key]; found {
+		if owner, clash := used[mac]; !clash || owner == vmname {
+			return mac, nil
+		}
+
+		m.logger.Warn("reserved mac address used by another vm", "vmname", vmname, "card", card, "mac", m.reservations[key], "owner", used[mac])
+	}
+
+	offset := derivedOffset(vmname, card)
+
+	for probe := uint32(0); probe < vmwareStaticRange; probe++ {
+		mac := staticMacAddress(offset + probe)
+
+		if _, clash := used[mac]; !clash {
+			if reserve {
+				m.reservations[key] = mac
+
+				if err := m.save(); err != nil {
+					delete(m.reservations, key)
+
+					return "", status.Errorf(codes.Internal, "failed to save MAC reservations: %s, reason: %v", m.path, err)
+				}
+			}
+
+			return mac, nil
+		}
+	}
+
+	return "", status.Errorf(codes.ResourceExhausted, "no free MAC address for NIC: %d of VM: %s", card, vmname)
+}
+
+// allocateMacAddresses return a copy of the request with the static MAC addresses resolved.
+// Caller supplied addresses are checked against the registered VMs.
+func (v *VmrunExe) allocateMacAddresses(request *CreateVirtualMachine, reserve bool) (*CreateVirtualMachine, error) {
+	resolved := *request
+	resolved.Networks = make([]*NetworkInterface, 0, len(request.Networks))
+
+	for card, network := range request.Networks {
+		inf := *network
+
+		// A DHCP reservation need a stable MAC address
+		if inf.Address != "" && (inf.MacAddress == "" || inf.MacAddress == MacAddressGenerated) {
+			inf.MacAddress = MacAddressStatic
+		}
+
+		if strings.EqualFold(inf.MacAddress, MacAddressStatic) {
+			if mac, err := v.macs.allocate(request.Name, card, reserve); err != nil {
+				return nil, err
+			} else {
+				inf.MacAddress = mac
+			}
+		} else if inf.MacAddress != "" && inf.MacAddress != MacAddressGenerated {
+			if err := v.macs.check(request.Name, inf.MacAddress); err != nil {
+				return nil, err
+			}
+		}
+
+		resolved.Networks = append(resolved.Networks, &inf)
+	}
+
+	return &resolved, nil
+}
@@ -0,0 +1,253 @@
+package service
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/Fred78290/vmrest-go-client/client/model"
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/status"
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/utility"
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/utils"
+	"github.com/hashicorp/go-hclog"
+	codes "google.golang.org/grpc/codes"
+)
+
+const poolsFile = "pools.json"
+
+type PoolStatus struct {
+	Template string   `json:"template"`
+	Size     int      `json:"size"`
+	Ready    []string `json:"ready"`
+	Filling  int      `json:"filling"`
+}
+
+// vmpool keep powered off clones of templates ready to be used by Create
+type vmpool struct {
+	sync.Mutex
+	once    sync.Once
+	vmrun   *VmrunExe
+	logger  hclog.Logger
+	path    string
+	sizes   map[string]int
+	ready   map[string][]string
+	filling map[string]int
+}
+
+func newVMPool(vmrun *VmrunExe, sizes map[string]int, folder string, logger hclog.Logger) *vmpool {
+	if sizes == nil {
+		sizes = map[string]int{}
+	}
+
+	p := &vmpool{
+		vmrun:   vmrun,
+		logger:  logger.Named("pool"),
+		sizes:   sizes,
+		ready:   make(map[string][]string),
+		filling: make(map[string]int),
+	}
+
+	if folder != "" {
+		p.path = filepath.Join(folder, poolsFile)
+	}
+
+	return p
+}
+
+func (p *vmpool) load() {
+	ready := make(map[string][]string)
+
+	if utils.FileExists(p.path) {
+		if err := utils.LoadJsonFromFile(p.path, &ready); err != nil {
+			p.logger.Warn("unable to load pools", "path", p.path, "error", err)
+		}
+	}
+
+	for template, vms := range ready {
+		alive := make([]string, 0, len(vms))
+
+		for _, vmuuid := range vms {
+			if _, err := p.vmrun.VirtualMachineByUUID(vmuuid); err == nil {
+				alive = append(alive, vmuuid)
+			} else {
+				p.logger.Warn("pooled vm vanished", "template", template, "vmuuid", vmuuid)
+			}
+		}
+
+		p.ready[template] = alive
+	}
+}
+
+func (p *vmpool) save() {
+	if err := utils.StoreJsonToFile(p.path, p.ready); err != nil {
+		p.logger.Error("unable to save pools", "path", p.path, "error", err)
+	}
+}
+
+func (p *vmpool) start() {
+	p.once.Do(func() {
+		if len(p.sizes) == 0 {
+			return
+		}
+
+		if p.path == "" {
+			p.path = utility.DirectoryForConfig(poolsFile)
+		}
+
+		p.Lock()
+		p.load()
+		p.save()
+		p.Unlock()
+
+		for template := range p.sizes {
+			go p.refill(template)
+		}
+	})
+}
+
+// take return the uuid of a pooled VM for the template or an empty string
+func (p *vmpool) take(template string) string {
+	p.Lock()
+	defer p.Unlock()
+
+	if vms := p.ready[template]; len(vms) > 0 {
+		p.ready[template] = vms[1:]
+		p.save()
+
+		return vms[0]
+	}
+
+	return ""
+}
+
+func (p *vmpool) refill(template string) {
+	for {
+		p.Lock()
+
+		if len(p.ready[template])+p.filling[template] >= p.sizes[template] {
+			p.Unlock()
+			return
+		}
+
+		p.filling[template]++
+		p.Unlock()
+
+		vm, err := p.vmrun.cloneForPool(template)
+
+		p.Lock()
+		p.filling[template]--
+
+		if err != nil {
+			p.Unlock()
+			p.logger.Error("unable to fill pool", "template", template, "error", err)
+			return
+		}
+
+		p.ready[template] = append(p.ready[template], vm.Uuid)
+		p.save()
+		p.Unlock()
+
+		p.logger.Info("pool filled", "template", template, "vmuuid", vm.Uuid, "name", vm.Name)
+	}
+}
+
+func (p *vmpool) status() []*PoolStatus {
+	p.Lock()
+	defer p.Unlock()
+
+	result := make([]*PoolStatus, 0, len(p.sizes))
+
+	for template, size := range p.sizes {
+		result = append(result, &PoolStatus{
+			Template: template,
+			Size:     size,
+			Ready:    append([]string{}, p.ready[template]...),
+			Filling:  p.filling[template],
+		})
+	}
+
+	return result
+}
+
+func (v *VmrunExe) cloneForPool(templateuuid string) (*VirtualMachine, error) {
+	defer v.locks.rlock(templateuuid)()
+
+	if template, err := v.VirtualMachineByUUID(templateuuid); err != nil {
+		return nil, status.Errorf(codes.NotFound, "Template: %s, not found", templateuuid)
+	} else {
+		name := fmt.Sprintf("%s-pool-%s", template.Name, strconv.FormatInt(time.Now().UnixNano(), 36))
+
+		if !v.clonevm {
+			return v.createVM(template, name)
+		} else if vmxpath, err := v.clone(template, name, false); err != nil {
+			return nil, err
+		} else if result, err := v.client.RegisterVM(&model.VmRegisterParameter{Name: name, Path: vmxpath}); err != nil {
+			return nil, err
+		} else {
+			return v.VirtualMachineByUUID(result.Id)
+		}
+	}
+}
+
+// createFromPool return nil without error when no pooled VM is available.
+// A pooled VM keep the directory and the vmrest registration name it was cloned with, <template>-pool-<timestamp>,
+// only its displayName is set to the requested name. A pooled VM failing to be prepared is deleted.
+func (v *VmrunExe) createFromPool(request *CreateVirtualMachine) (*VirtualMachine, error) {
+	if vmuuid := v.pool.take(request.Template); vmuuid == "" {
+		return nil, nil
+	} else {
+		defer func() {
+			go v.pool.refill(request.Template)
+		}()
+
+		defer v.locks.lock(vmuuid)()
+
+		if vm, err := v.VirtualMachineByUUID(vmuuid); err != nil {
+			v.logger.Warn("pooled vm not found, fallback to clone", "template", request.Template, "vmuuid", vmuuid, "error", err)
+
+			return nil, nil
+		} else {
+			// Pooled VM is already registered
+			prepare := *request
+			prepare.Register = false
+
+			if err := v.prepareVM(&prepare, vm); err != nil {
+				// The VMX could be half edited, the VM can't go back to the pool
+				v.discardPooledVM(vm)
+
+				return nil, status.Errorf(codes.FailedPrecondition, "failed to prepare pooled VM: %s, reason: %v", vm.Path, err)
+			}
+
+			v.deleteCachedVM(vm)
+
+			return v.VirtualMachineByUUID(vmuuid)
+		}
+	}
+}
+
+// discardPooledVM delete a pooled VM already taken from the pool, the caller hold its lock
+func (v *VmrunExe) discardPooledVM(vm *VirtualMachine) {
+	var err error
+
+	if v.clonevm {
+		_, err = v.deleteWithVMRun(vm.Uuid)
+	} else {
+		_, err = v.deleteWithVMRest(vm.Uuid)
+	}
+
+	if err != nil {
+		v.logger.Error("unable to delete pooled vm", "vmuuid", vm.Uuid, "path", vm.Path, "error", err)
+	} else {
+		v.logger.Info("pooled vm deleted", "vmuuid", vm.Uuid, "path", vm.Path)
+	}
+}
+
+func (v *VmrunExe) StartPools() {
+	v.pool.start()
+}
+
+func (v *VmrunExe) ListPools() []*PoolStatus {
+	return v.pool.status()
+}
@@ -0,0 +1,267 @@
+package service
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/Fred78290/vmrest-go-client/client/model"
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/status"
+	codes "google.golang.org/grpc/codes"
+)
+
+// portForwardTag prefix the description of the forwards owned by a VM, it's followed by the VM uuid
+const portForwardTag = "autoscaler:"
+
+// PortForward is a NAT port forward from the host to a VM
+type PortForward struct {
+	Vmnet       string `json:"vmnet,omitempty"`
+	Protocol    string `json:"protocol,omitempty"`
+	HostPort    int    `json:"hostPort,omitempty"`
+	GuestIP     string `json:"guestIp,omitempty"`
+	GuestPort   int    `json:"guestPort,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+func portForwardDescription(vmuuid, description string) string {
+	if description == "" {
+		return portForwardTag + vmuuid
+	}
+
+	return portForwardTag + vmuuid + " " + description
+}
+
+// portForwardOwner return the uuid of the VM tagged in the description
+func portForwardOwner(description string) (vmuuid, remain string) {
+	if strings.HasPrefix(description, portForwardTag) {
+		tagged := strings.SplitN(strings.TrimPrefix(description, portForwardTag), " ", 2)
+
+		if len(tagged) > 1 {
+			return tagged[0], tagged[1]
+		}
+
+		return tagged[0], ""
+	}
+
+	return "", description
+}
+
+// natNetworks return the NAT vmnets, restricted to vmnet if not empty
+func (v *VmrunExe) natNetworks(vmnet string) ([]string, error) {
+	if networks, err := v.client.GetAllNetworks(); err != nil {
+		return nil, err
+	} else {
+		result := make([]string, 0, 1)
+
+		for _, network := range networks.Vmnets {
+			if network.Type == "nat" && (vmnet == "" || network.Name == vmnet) {
+				result = append(result, network.Name)
+			}
+		}
+
+		if len(result) == 0 && vmnet != "" {
+			return nil, fmt.Errorf("vmnet: %s, is not a NAT network", vmnet)
+		} else if len(result) == 0 {
+			return nil, fmt.Errorf("no NAT network found")
+		}
+
+		return result, nil
+	}
+}
+
+// natAddress resolve the IPv4 address of the VM on the NAT vmnet, the address is read from the guest
+// when powered and from the DHCP reservations otherwise
+func (v *VmrunExe) natAddress(vm *VirtualMachine, vmnet string) (string, error) {
+	if nics, err := v.client.GetAllNICDevices(vm.Uuid); err != nil {
+		return "", err
+	} else {
+		for _, nic := range nics.Nics {
+			if (nic.Type == "nat" && vmnet == "vmnet8") || (nic.Type == "custom" && path.Base(nic.Vmnet) == vmnet) {
+				mac := strings.ToLower(nic.MacAddress)
+
+				if vm.PowerState.Powered() {
+					if stack, err := v.getNicInfoPowered(vm); err == nil {
+						if address := v.getNicAddress(mac, stack); address != "" {
+							return address, nil
+						}
+					}
+				}
+
+				if mactoips, err := v.client.GetMACToIPs(vmnet); err == nil {
+					for _, mactoip := range mactoips.Mactoips {
+						if strings.ToLower(mactoip.Mac) == mac {
+							return mactoip.Ip, nil
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return "", fmt.Errorf("no address found for VM: %s on vmnet: %s", vm.Uuid, vmnet)
+}
+
+func (v *VmrunExe) portForwards(vmnet string) ([]model.Portforward, error) {
+	if forwards, err := v.client.GetPortforwards(vmnet); err != nil {
+		return nil, err
+	} else {
+		return forwards.PortForwardings, nil
+	}
+}
+
+func toPortForward(vmnet string, forward model.Portforward) *PortForward {
+	_, description := portForwardOwner(forward.Desc)
+
+	result := &PortForward{
+		Vmnet:       vmnet,
+		Protocol:    forward.Protocol,
+		HostPort:    forward.Port,
+		Description: description,
+	}
+
+	if forward.Guest != nil {
+		result.GuestIP = forward.Guest.Ip
+		result.GuestPort = forward.Guest.Port
+	}
+
+	return result
+}
+
+// ListPortForwards return the forwards tagged with the VM uuid on all the NAT vmnets
+func (v *VmrunExe) ListPortForwards(vmuuid string) ([]*PortForward, error) {
+	if _, err := v.VirtualMachineByUUID(vmuuid); err != nil {
+		return nil, status.Errorf(codes.NotFound, failedtofindvm, vmuuid, err)
+	} else if vmnets, err := v.natNetworks(""); err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to list port forwards of VM: %s, reason: %v", vmuuid, err)
+	} else {
+		result := make([]*PortForward, 0)
+
+		for _, vmnet := range vmnets {
+			if forwards, err := v.portForwards(vmnet); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to list port forwards of vmnet: %s, reason: %v", vmnet, err)
+			} else {
+				for _, forward := range forwards {
+					if owner, _ := portForwardOwner(forward.Desc); owner == vmuuid {
+						result = append(result, toPortForward(vmnet, forward))
+					}
+				}
+			}
+		}
+
+		return result, nil
+	}
+}
+
+// AddPortForward publish a host port to the VM, the guest address is resolved on the NAT vmnet if not given.
+// The guest port default to the host port. A host port forwarded to another VM is refused.
+func (v *VmrunExe) AddPortForward(vmuuid string, forward *PortForward) (*PortForward, error) {
+	defer v.locks.lock(vmuuid)()
+
+	request := *forward
+	request.Protocol = strings.ToLower(request.Protocol)
+
+	if request.Protocol == "" {
+		request.Protocol = "tcp"
+	}
+
+	if request.GuestPort == 0 {
+		request.GuestPort = request.HostPort
+	}
+
+	if request.Protocol != "tcp" && request.Protocol != "udp" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid protocol: %s, expected tcp or udp", forward.Protocol)
+	} else if request.HostPort < 1 || request.HostPort > 65535 || request.GuestPort < 1 || request.GuestPort > 65535 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid ports: %d -> %d, expected between 1 and 65535", request.HostPort, request.GuestPort)
+	} else if vm, err := v.VirtualMachineByUUID(vmuuid); err != nil {
+		return nil, status.Errorf(codes.NotFound, failedtofindvm, vmuuid, err)
+	} else if vmnets, err := v.natNetworks(request.Vmnet); err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to add port forward to VM: %s, reason: %v", vmuuid, err)
+	} else {
+		request.Vmnet = vmnets[0]
+
+		// The host ports are checked then claimed, the forwards of the other VMs on the vmnet must not change meanwhile
+		defer v.LockVmnet(request.Vmnet)()
+
+		if request.GuestIP == "" {
+			if request.GuestIP, err = v.natAddress(vm, request.Vmnet); err != nil {
+				return nil, status.Errorf(codes.FailedPrecondition, "failed to add port forward to VM: %s, reason: %v", vmuuid, err)
+			}
+		}
+
+		if forwards, err := v.portForwards(request.Vmnet); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list port forwards of vmnet: %s, reason: %v", request.Vmnet, err)
+		} else {
+			for _, existing := range forwards {
+				if owner, _ := portForwardOwner(existing.Desc); existing.Protocol == request.Protocol && existing.Port == request.HostPort && owner != vmuuid {
+					return nil, status.Errorf(codes.AlreadyExists, "host port: %s/%d on vmnet: %s, already forwarded: %s", request.Protocol, request.HostPort, request.Vmnet, existing.Desc)
+				}
+			}
+		}
+
+		parameter := &model.PortforwardParameter{
+			GuestIp:   request.GuestIP,
+			GuestPort: request.GuestPort,
+			Desc:      portForwardDescription(vmuuid, request.Description),
+		}
+
+		if _, err = v.client.UpdatePortforward(request.Vmnet, request.Protocol, request.HostPort, parameter); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to add port forward to VM: %s, reason: %v", vmuuid, err)
+		}
+
+		v.logger.Info("port forward added", "vmuuid", vmuuid, "vmnet", request.Vmnet, "protocol", request.Protocol, "port", request.HostPort, "guest", request.GuestIP, "guestPort", request.GuestPort)
+
+		return &request, nil
+	}
+}
+
+// DeletePortForward remove a forward of the VM, forwards of other VMs are not touched
+func (v *VmrunExe) DeletePortForward(vmuuid, protocol string, hostPort int) (bool, error) {
+	defer v.locks.lock(vmuuid)()
+
+	if protocol = strings.ToLower(protocol); protocol == "" {
+		protocol = "tcp"
+	}
+
+	if forwards, err := v.ListPortForwards(vmuuid); err != nil {
+		return false, err
+	} else {
+		for _, forward := range forwards {
+			if forward.Protocol == protocol && forward.HostPort == hostPort {
+				defer v.LockVmnet(forward.Vmnet)()
+
+				if err = v.client.DeletePortforward(forward.Vmnet, forward.Protocol, forward.HostPort); err != nil {
+					return false, status.Errorf(codes.Internal, "failed to delete port forward: %s/%d of VM: %s, reason: %v", protocol, hostPort, vmuuid, err)
+				}
+
+				return true, nil
+			}
+		}
+
+		return false, status.Errorf(codes.NotFound, "port forward: %s/%d of VM: %s, not found", protocol, hostPort, vmuuid)
+	}
+}
+
+// prunePortForwards remove the forwards tagged with the uuid of a deleted VM
+func (v *VmrunExe) prunePortForwards(vmuuid string) {
+	if vmnets, err := v.natNetworks(""); err == nil {
+		for _, vmnet := range vmnets {
+			unlock := v.LockVmnet(vmnet)
+
+			if forwards, err := v.portForwards(vmnet); err != nil {
+				v.logger.Warn("unable to list port forwards", "vmnet", vmnet, "error", err)
+			} else {
+				for _, forward := range forwards {
+					if owner, _ := portForwardOwner(forward.Desc); owner == vmuuid {
+						if err = v.client.DeletePortforward(vmnet, forward.Protocol, forward.Port); err != nil {
+							v.logger.Warn("unable to prune port forward", "vmuuid", vmuuid, "vmnet", vmnet, "protocol", forward.Protocol, "port", forward.Port, "error", err)
+						} else {
+							v.logger.Debug("port forward pruned", "vmuuid", vmuuid, "vmnet", vmnet, "protocol", forward.Protocol, "port", forward.Port)
+						}
+					}
+				}
+			}
+
+			unlock()
+		}
+	}
+}
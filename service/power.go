@@ -0,0 +1,122 @@
+package service
+
+import (
+	"encoding/json"
+	"os/exec"
+
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/status"
+	vagrant_utility "github.com/hashicorp/vagrant-vmware-desktop/go_src/vagrant-vmware-utility/utility"
+	codes "google.golang.org/grpc/codes"
+)
+
+type PowerState string
+
+const (
+	PoweredOn  PowerState = "poweredOn"
+	PoweredOff PowerState = "poweredOff"
+	Suspended  PowerState = "suspended"
+	Paused     PowerState = "paused"
+)
+
+const checkpointKey = "checkpoint.vmState"
+
+// Powered returns true if the VM process is alive, running or paused
+func (p PowerState) Powered() bool {
+	return p == PoweredOn || p == Paused
+}
+
+// MarshalJSON keep the powered flag of the previous API next to powerState
+func (vm VirtualMachine) MarshalJSON() ([]byte, error) {
+	type virtualMachine VirtualMachine
+
+	return json.Marshal(struct {
+		virtualMachine
+		Powered bool `json:"powered"`
+	}{virtualMachine(vm), vm.PowerState.Powered()})
+}
+
+// MarshalJSON keep the powered flag of the previous API next to powerState
+func (s VirtualMachineStatus) MarshalJSON() ([]byte, error) {
+	type virtualMachineStatus VirtualMachineStatus
+
+	return json.Marshal(struct {
+		virtualMachineStatus
+		Powered bool `json:"powered"`
+	}{virtualMachineStatus(s), s.PowerState.Powered()})
+}
+
+func (v *VmrunExe) changePowerState(vm *VirtualMachine, wanted PowerState, args ...string) (bool, error) {
+	cmd := exec.Command(v.exePath, append([]string{args[0], vm.Path}, args[1:]...)...)
+	exitCode, out := vagrant_utility.ExecuteWithOutput(cmd)
+
+	v.running.invalidate()
+
+	if exitCode != 0 {
+		v.logger.Debug("vmrun "+args[0]+" failed", "exitcode", exitCode)
+		v.logger.Trace("vmrun "+args[0]+" failed", "output", out)
+
+		return false, status.Errorf(codes.Internal, "failed to %s VM: %s, reason: %s", args[0], vm.Uuid, out)
+	}
+
+	vm.PowerState = wanted
+
+	return true, nil
+}
+
+func (v *VmrunExe) Suspend(vmuuid, mode string) (bool, error) {
+	defer v.locks.lock(vmuuid)()
+
+	if found, err := v.VirtualMachineByUUID(vmuuid); err != nil {
+		return false, status.Errorf(codes.NotFound, failedtofindvm, vmuuid, err)
+	} else if found.PowerState == Suspended {
+		return true, nil
+	} else if !found.PowerState.Powered() {
+		return false, status.Errorf(codes.FailedPrecondition, "failed to suspend VM: %s, reason: not powered", vmuuid)
+	} else if mode != "" {
+		return v.changePowerState(found, Suspended, "suspend", mode)
+	} else {
+		return v.changePowerState(found, Suspended, "suspend")
+	}
+}
+
+func (v *VmrunExe) Pause(vmuuid string) (bool, error) {
+	defer v.locks.lock(vmuuid)()
+
+	if found, err := v.VirtualMachineByUUID(vmuuid); err != nil {
+		return false, status.Errorf(codes.NotFound, failedtofindvm, vmuuid, err)
+	} else if found.PowerState == Paused {
+		return true, nil
+	} else if found.PowerState != PoweredOn {
+		return false, status.Errorf(codes.FailedPrecondition, "failed to pause VM: %s, reason: not running", vmuuid)
+	} else {
+		return v.changePowerState(found, Paused, "pause")
+	}
+}
+
+func (v *VmrunExe) Unpause(vmuuid string) (bool, error) {
+	defer v.locks.lock(vmuuid)()
+
+	if found, err := v.VirtualMachineByUUID(vmuuid); err != nil {
+		return false, status.Errorf(codes.NotFound, failedtofindvm, vmuuid, err)
+	} else if found.PowerState == PoweredOn {
+		return true, nil
+	} else if found.PowerState != Paused {
+		return false, status.Errorf(codes.FailedPrecondition, "failed to unpause VM: %s, reason: not paused", vmuuid)
+	} else {
+		return v.changePowerState(found, PoweredOn, "unpause")
+	}
+}
+
+func (v *VmrunExe) Reset(vmuuid, mode string) (bool, error) {
+	defer v.locks.lock(vmuuid)()
+
+	if found, err := v.VirtualMachineByUUID(vmuuid); err != nil {
+		return false, status.Errorf(codes.NotFound, failedtofindvm, vmuuid, err)
+	} else if found.PowerState != PoweredOn {
+		return false, status.Errorf(codes.FailedPrecondition, "failed to reset VM: %s, reason: not running", vmuuid)
+	} else if mode != "" {
+		return v.changePowerState(found, PoweredOn, "reset", mode)
+	} else {
+		return v.changePowerState(found, PoweredOn, "reset")
+	}
+}
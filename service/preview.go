@@ -0,0 +1,35 @@
+package service
+
+import (
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/status"
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/utils"
+	codes "google.golang.org/grpc/codes"
+)
+
+// PreviewCreate run the VMX edits of a create request against the template in memory, nothing is cloned or written.
+// The result is the difference between the template VMX and the VMX the clone would get.
+func (v *VmrunExe) PreviewCreate(request *CreateVirtualMachine) (*utils.VMXDiff, error) {
+	defer v.locks.rlock(request.Template)()
+
+	if request.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "VM name is empty")
+	} else if _, err := v.VirtualMachineByName(request.Name); err == nil {
+		return nil, status.Errorf(codes.AlreadyExists, "VM named: %s, already exists", request.Name)
+	} else if request, err = v.allocateMacAddresses(request, false); err != nil {
+		return nil, err
+	} else if _, err = v.requestedReservations(request); err != nil {
+		return nil, err
+	} else if template, err := v.VirtualMachineByUUID(request.Template); err != nil {
+		return nil, status.Errorf(codes.NotFound, "Template: %s, not found", request.Template)
+	} else if vmx, err := utils.LoadVMX(template.Path); err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to load VMX: %s, reason: %v", template.Path, err)
+	} else {
+		preview := vmx.Clone()
+
+		if err = v.editVMX(request, preview); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to prepare VMX: %s, reason: %v", template.Path, err)
+		}
+
+		return utils.DiffVMX(vmx, preview), nil
+	}
+}
@@ -0,0 +1,116 @@
+package service
+
+import (
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/status"
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/utils"
+	codes "google.golang.org/grpc/codes"
+)
+
+// ReconfigureVirtualMachine describe the new sizing of a VM, zero values are left unchanged
+type ReconfigureVirtualMachine struct {
+	Vcpus        int `json:"vcpus,omitempty"`
+	Memory       int `json:"memory,omitempty"`
+	DiskSizeInMb int `json:"diskSizeInMB,omitempty"`
+}
+
+// Reconfigure change the vcpus, the memory or grow the first disk of a VM. The VM must be powered off,
+// even for a vcpus or memory only change, VMware read the VMX at power on. The disk can't be shrunk.
+func (v *VmrunExe) Reconfigure(vmuuid string, spec *ReconfigureVirtualMachine) (*VirtualMachine, error) {
+	defer v.locks.lock(vmuuid)()
+
+	if spec.Vcpus < 0 || spec.Memory < 0 || spec.DiskSizeInMb < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to reconfigure VM: %s, reason: negative value", vmuuid)
+	} else if vm, err := v.VirtualMachineByUUID(vmuuid); err != nil {
+		return nil, status.Errorf(codes.NotFound, failedtofindvm, vmuuid, err)
+	} else if spec.Vcpus == 0 && spec.Memory == 0 && spec.DiskSizeInMb == 0 {
+		return vm, nil
+	} else if vm.PowerState != PoweredOff {
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to reconfigure VM: %s, reason: not powered off", vmuuid)
+	} else if vmx, err := utils.LoadVMX(vm.Path); err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to load VMX: %s, reason: %v", vm.Path, err)
+	} else if err = resizeVMX(vmx, spec.Vcpus, spec.Memory); err != nil {
+		return nil, err
+	} else if err = v.checkDiskGrowth(vm, vmx, spec.DiskSizeInMb); err != nil {
+		return nil, err
+	} else {
+		// The disk growth can't be rolled back, the VMX is only saved once the disk is expanded
+		if err = v.expandDisk(vm.Path, spec.DiskSizeInMb, vmx); err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "failed to expand disk: %s, reason: %v", vm.Path, err)
+		}
+
+		if err = vmx.Save(vm.Path); err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "failed to save VMX: %s, reason: %v", vm.Path, err)
+		}
+
+		sizing := utils.ReadCPUMemory(vmx)
+
+		vm.Vcpus = sizing.Vcpus
+		vm.Memory = sizing.MemoryInMb
+
+		return vm, nil
+	}
+}
+
+// resizeVMX write the sizing through the schema, zero values keep the current sizing
+func resizeVMX(vmx *utils.VMXMap, vcpus, memory int) error {
+	sizing := utils.ReadCPUMemory(vmx)
+
+	if vcpus > 0 {
+		sizing.Vcpus = vcpus
+
+		// The topology of the template doesn't fit, let VMware use one core per socket
+		if sizing.CoresPerSocket > 0 && vcpus%sizing.CoresPerSocket != 0 {
+			sizing.CoresPerSocket = 0
+		}
+	}
+
+	if memory > 0 {
+		sizing.MemoryInMb = memory
+	}
+
+	if err := sizing.Write(vmx); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid sizing, reason: %v", err)
+	}
+
+	return nil
+}
+
+// checkDiskGrowth verify the first disk isn't the delta disk of a linked clone and its new size is larger than its current size,
+// vmware-vdiskmanager doesn't fail on a shrink or a same size request and expandDisk ignore its failure on a delta disk
+func (v *VmrunExe) checkDiskGrowth(vm *VirtualMachine, vmx *utils.VMXMap, diskSizeInMb int) error {
+	if diskSizeInMb == 0 {
+		return nil
+	} else if vmdk, err := bootDisk(vm.Path, vmx); err != nil {
+		return status.Errorf(codes.FailedPrecondition, "failed to expand disk: %s, reason: %v", vm.Path, err)
+	} else if parent := vmdkParent(vmdk); parent != "" {
+		return status.Errorf(codes.FailedPrecondition, "failed to expand disk: %s, reason: delta disk of linked clone, parent: %s", vmdk, parent)
+	} else if current, err := vmdkSizeInMb(vmdk); err != nil {
+		return status.Errorf(codes.FailedPrecondition, "failed to read the size of VMDK: %s, reason: %v", vmdk, err)
+	} else if diskSizeInMb <= current {
+		return status.Errorf(codes.InvalidArgument, "failed to expand disk: %s, reason: new size: %dMB is not larger than: %dMB", vmdk, diskSizeInMb, current)
+	}
+
+	return nil
+}
+
+// RestoreVMX roll back the last generations edits of the VMX, the VM must be powered off
+func (v *VmrunExe) RestoreVMX(vmuuid string, generations int) (*VirtualMachine, error) {
+	defer v.locks.lock(vmuuid)()
+
+	if generations < 1 || generations > utils.VMXBackupGenerations {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to restore VM: %s, reason: generations must be between 1 and %d", vmuuid, utils.VMXBackupGenerations)
+	} else if vm, err := v.VirtualMachineByUUID(vmuuid); err != nil {
+		return nil, status.Errorf(codes.NotFound, failedtofindvm, vmuuid, err)
+	} else if vm.PowerState != PoweredOff {
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to restore VM: %s, reason: not powered off", vmuuid)
+	} else if backups := utils.VMXBackups(vm.Path); len(backups) < generations {
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to restore VM: %s, reason: only %d backups available", vmuuid, len(backups))
+	} else if err = utils.RestoreVMX(vm.Path, generations); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to restore VM: %s, reason: %v", vmuuid, err)
+	} else {
+		// The name, cpus or memory could have changed
+		v.deleteCachedVM(vm)
+
+		return v.VirtualMachineByUUID(vmuuid)
+	}
+}
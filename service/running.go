@@ -0,0 +1,51 @@
+package service
+
+import (
+	"sync"
+	"time"
+)
+
+// Lifetime of the vmrun list result shared by all power state lookups
+const runningVMTTL = 2 * time.Second
+
+// runningCache hold the last vmrun list result, so a refresh of N VMs spawn vmrun once and not N times
+type runningCache struct {
+	sync.Mutex
+	ttl     time.Duration
+	fetched time.Time
+	running map[string]bool
+}
+
+func newRunningCache(ttl time.Duration) *runningCache {
+	return &runningCache{
+		ttl: ttl,
+	}
+}
+
+func (c *runningCache) get(fetch func() (map[string]bool, error)) (map[string]bool, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	if c.running != nil && time.Since(c.fetched) < c.ttl {
+		return c.running, nil
+	}
+
+	running, err := fetch()
+
+	if err != nil {
+		return nil, err
+	}
+
+	c.running = running
+	c.fetched = time.Now()
+
+	return running, nil
+}
+
+// invalidate must be called after any vmrun command changing the power state of a VM
+func (c *runningCache) invalidate() {
+	c.Lock()
+	defer c.Unlock()
+
+	c.running = nil
+}
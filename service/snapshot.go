@@ -0,0 +1,251 @@
+package service
+
+import (
+	"os/exec"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/status"
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/utils"
+	vagrant_utility "github.com/hashicorp/vagrant-vmware-desktop/go_src/vagrant-vmware-utility/utility"
+	codes "google.golang.org/grpc/codes"
+)
+
+const (
+	snapshotKey           = "snapshot"
+	snapshotCurrentKey    = "snapshot.current"
+	snapshotNumKey        = "snapshot.numSnapshots"
+	vmrunsnapshotfailed   = "vmrun snapshot failed"
+	failedtofindsnapshot  = "failed to find snapshot: %s for VM: %s"
+	failedtoloadsnapshots = "failed to load snapshots for VM: %s, reason: %v"
+)
+
+var snapshotUIDRegexp = regexp.MustCompile(`^snapshot(\d+)\.uid$`)
+
+type Snapshot struct {
+	Name        string      `json:"name"`
+	Description string      `json:"description,omitempty"`
+	CreateTime  time.Time   `json:"createTime"`
+	Current     bool        `json:"current,omitempty"`
+	Children    []*Snapshot `json:"children,omitempty"`
+}
+
+type snapshotEntry struct {
+	prefix   string
+	uid      string
+	parent   string
+	clones   []string
+	snapshot *Snapshot
+}
+
+func vmsdPath(vmxpath string) string {
+	return strings.TrimSuffix(vmxpath, ".vmx") + ".vmsd"
+}
+
+// VMware store snapshot creation time in microseconds split in two 32 bits values
+func snapshotCreateTime(high, low string) time.Time {
+	usec := int64(utils.StrToInt(high))<<32 | int64(uint32(utils.StrToInt(low)))
+
+	return time.UnixMicro(usec)
+}
+
+func (v *VmrunExe) loadSnapshots(vm *VirtualMachine) (entries []*snapshotEntry, vmsd *utils.VMXMap, err error) {
+	vmsdpath := vmsdPath(vm.Path)
+
+	if !utils.FileExists(vmsdpath) {
+		return []*snapshotEntry{}, nil, nil
+	}
+
+	if vmsd, err = utils.LoadVMX(vmsdpath); err != nil {
+		return nil, nil, err
+	}
+
+	current := vmsd.Get(snapshotCurrentKey)
+	indexes := make([]int, 0, utils.StrToInt(vmsd.Get(snapshotNumKey)))
+
+	for _, key := range vmsd.Keys() {
+		if match := snapshotUIDRegexp.FindStringSubmatch(key); match != nil {
+			indexes = append(indexes, utils.StrToInt(match[1]))
+		}
+	}
+
+	sort.Ints(indexes)
+
+	entries = make([]*snapshotEntry, 0, len(indexes))
+
+	for _, index := range indexes {
+		prefix := snapshotKey + strconv.Itoa(index) + "."
+		uid := vmsd.Get(prefix + "uid")
+		clones := make([]string, 0, utils.StrToInt(vmsd.Get(prefix+"numClones")))
+
+		for clone := 0; clone < cap(clones); clone++ {
+			if vmxpath := vmsd.Get(prefix + "clone" + strconv.Itoa(clone)); vmxpath != "" {
+				clones = append(clones, vmxpath)
+			}
+		}
+
+		entries = append(entries, &snapshotEntry{
+			prefix: prefix,
+			uid:    uid,
+			parent: vmsd.Get(prefix + "parent"),
+			clones: clones,
+			snapshot: &Snapshot{
+				Name:        vmsd.Get(prefix + "displayName"),
+				Description: vmsd.Get(prefix + "description"),
+				CreateTime:  snapshotCreateTime(vmsd.Get(prefix+"createTimeHigh"), vmsd.Get(prefix+"createTimeLow")),
+				Current:     uid == current,
+			},
+		})
+	}
+
+	return entries, vmsd, nil
+}
+
+func (v *VmrunExe) snapshotTree(entries []*snapshotEntry) []*Snapshot {
+	roots := make([]*Snapshot, 0, len(entries))
+	byuid := make(map[string]*snapshotEntry)
+
+	for _, entry := range entries {
+		byuid[entry.uid] = entry
+	}
+
+	for _, entry := range entries {
+		if parent, found := byuid[entry.parent]; found && entry.parent != "" {
+			parent.snapshot.Children = append(parent.snapshot.Children, entry.snapshot)
+		} else {
+			roots = append(roots, entry.snapshot)
+		}
+	}
+
+	return roots
+}
+
+func (v *VmrunExe) setSnapshotDescription(vm *VirtualMachine, name, description string) error {
+	if entries, vmsd, err := v.loadSnapshots(vm); err != nil {
+		return err
+	} else {
+		for _, entry := range entries {
+			if entry.snapshot.Name == name && entry.snapshot.Current {
+				vmsd.Set(entry.prefix+"description", description)
+
+				return vmsd.Save(vmsdPath(vm.Path))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (v *VmrunExe) findSnapshot(vm *VirtualMachine, name string) (*Snapshot, error) {
+	if entries, _, err := v.loadSnapshots(vm); err != nil {
+		return nil, status.Errorf(codes.Internal, failedtoloadsnapshots, vm.Uuid, err)
+	} else {
+		for _, entry := range entries {
+			if entry.snapshot.Name == name {
+				return entry.snapshot, nil
+			}
+		}
+	}
+
+	return nil, status.Errorf(codes.NotFound, failedtofindsnapshot, name, vm.Uuid)
+}
+
+func (v *VmrunExe) ListSnapshots(vmuuid string) ([]*Snapshot, error) {
+	if vm, err := v.VirtualMachineByUUID(vmuuid); err != nil {
+		return nil, status.Errorf(codes.NotFound, failedtofindvm, vmuuid, err)
+	} else if entries, _, err := v.loadSnapshots(vm); err != nil {
+		return nil, status.Errorf(codes.Internal, failedtoloadsnapshots, vmuuid, err)
+	} else {
+		return v.snapshotTree(entries), nil
+	}
+}
+
+func (v *VmrunExe) takeSnapshot(vm *VirtualMachine, name, description string) (*Snapshot, error) {
+	cmd := exec.Command(v.exePath, "snapshot", vm.Path, name)
+	exitCode, out := vagrant_utility.ExecuteWithOutput(cmd)
+
+	if exitCode != 0 {
+		v.logger.Debug(vmrunsnapshotfailed, "exitcode", exitCode)
+		v.logger.Trace(vmrunsnapshotfailed, "output", out)
+
+		return nil, status.Errorf(codes.Internal, "failed to take snapshot: %s for VM: %s, reason: %s", name, vm.Uuid, out)
+	}
+
+	if description != "" {
+		if err := v.setSnapshotDescription(vm, name, description); err != nil {
+			v.logger.Warn("unable to set snapshot description", "vmuuid", vm.Uuid, "snapshot", name, "error", err)
+		}
+	}
+
+	return v.findSnapshot(vm, name)
+}
+
+func (v *VmrunExe) TakeSnapshot(vmuuid, name, description string) (*Snapshot, error) {
+	defer v.locks.lock(vmuuid)()
+
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "snapshot name is empty")
+	} else if vm, err := v.VirtualMachineByUUID(vmuuid); err != nil {
+		return nil, status.Errorf(codes.NotFound, failedtofindvm, vmuuid, err)
+	} else if _, err := v.findSnapshot(vm, name); err == nil {
+		return nil, status.Errorf(codes.AlreadyExists, "snapshot: %s already exists for VM: %s", name, vmuuid)
+	} else {
+		return v.takeSnapshot(vm, name, description)
+	}
+}
+
+func (v *VmrunExe) RevertToSnapshot(vmuuid, name string) (bool, error) {
+	defer v.locks.lock(vmuuid)()
+
+	if vm, err := v.VirtualMachineByUUID(vmuuid); err != nil {
+		return false, status.Errorf(codes.NotFound, failedtofindvm, vmuuid, err)
+	} else if _, err := v.findSnapshot(vm, name); err != nil {
+		return false, err
+	} else {
+		cmd := exec.Command(v.exePath, "revertToSnapshot", vm.Path, name)
+		exitCode, out := vagrant_utility.ExecuteWithOutput(cmd)
+
+		// The snapshot may hold another power state
+		v.running.invalidate()
+
+		if exitCode != 0 {
+			v.logger.Debug("vmrun revertToSnapshot failed", "exitcode", exitCode)
+			v.logger.Trace("vmrun revertToSnapshot failed", "output", out)
+
+			return false, status.Errorf(codes.Internal, "failed to revert VM: %s to snapshot: %s, reason: %s", vmuuid, name, out)
+		}
+	}
+
+	return true, nil
+}
+
+func (v *VmrunExe) DeleteSnapshot(vmuuid, name string, deleteChildren bool) (bool, error) {
+	defer v.locks.lock(vmuuid)()
+
+	if vm, err := v.VirtualMachineByUUID(vmuuid); err != nil {
+		return false, status.Errorf(codes.NotFound, failedtofindvm, vmuuid, err)
+	} else if _, err := v.findSnapshot(vm, name); err != nil {
+		return false, err
+	} else {
+		args := []string{"deleteSnapshot", vm.Path, name}
+
+		if deleteChildren {
+			args = append(args, "andDeleteChildren")
+		}
+
+		cmd := exec.Command(v.exePath, args...)
+		exitCode, out := vagrant_utility.ExecuteWithOutput(cmd)
+
+		if exitCode != 0 {
+			v.logger.Debug("vmrun deleteSnapshot failed", "exitcode", exitCode)
+			v.logger.Trace("vmrun deleteSnapshot failed", "output", out)
+
+			return false, status.Errorf(codes.Internal, "failed to delete snapshot: %s for VM: %s, reason: %s", name, vmuuid, out)
+		}
+	}
+
+	return true, nil
+}
@@ -7,7 +7,7 @@ import (
 	"os"
 	"os/exec"
 	"path"
-	"strconv"
+	"path/filepath"
 	"strings"
 	"sync"
 	"time"
@@ -25,8 +25,6 @@ import (
 
 const (
 	vmnameKey       = "displayname"
-	numcpusKey      = "numvcpus"
-	memsizeKey      = "memsize"
 	autostartKey    = "autostart"
 	guestinfoKey    = "guestinfo."
 	vmrunlistfailed = "vmrun list failed"
@@ -35,8 +33,6 @@ const (
 	failedtofindvm  = "failed to find VM: %s, reason: %v"
 )
 
-var pcislotnumber = []string{"160", "192", "161", "193", "225"}
-
 type EthernetCard struct {
 	AddressType          string `json:"addressType,omitempty"`
 	BsdName              string `json:"bsdName,omitempty"`
@@ -53,7 +49,7 @@ type EthernetCard struct {
 }
 
 type VirtualMachineStatus struct {
-	Powered       bool            `json:"powered"`
+	PowerState    PowerState      `json:"powerState"`
 	EthernetCards []*EthernetCard `json:"ethernet,omitempty"`
 }
 
@@ -64,6 +60,7 @@ type NetworkInterface struct {
 	Device         string `json:"device,omitempty"`
 	BsdName        string `json:"bsdName,omitempty"`
 	DisplayName    string `json:"displayName,omitempty"`
+	Address        string `json:"address,omitempty"`
 }
 
 type NetworkDevice struct {
@@ -97,13 +94,32 @@ type Vmrun interface {
 	SetApiClient(*client.APIClient)
 	RunningVms() ([]*VirtualMachine, error)
 	Create(request *CreateVirtualMachine) (*VirtualMachine, error)
+	PreviewCreate(request *CreateVirtualMachine) (*utils.VMXDiff, error)
 	Delete(vmuuid string) (bool, error)
+	Reconfigure(vmuuid string, spec *ReconfigureVirtualMachine) (*VirtualMachine, error)
+	RestoreVMX(vmuuid string, generations int) (*VirtualMachine, error)
+	ListDisks(vmuuid string) ([]*VirtualDisk, error)
+	AddDisk(vmuuid string, request *CreateVirtualDisk) (*VirtualDisk, error)
+	RemoveDisk(vmuuid, slot string, deleteFile bool) (bool, error)
+	RunProgramInGuest(vmuuid string, creds *GuestCredentials, program string, args []string, noWait bool) (int, error)
+	RunScriptInGuest(vmuuid string, creds *GuestCredentials, interpreter, script string, noWait bool) (int, error)
+	CopyFileFromHostToGuest(vmuuid string, creds *GuestCredentials, hostPath, guestPath string) (bool, error)
+	CopyFileFromGuestToHost(vmuuid string, creds *GuestCredentials, guestPath, hostPath string) (bool, error)
+	ListProcessesInGuest(vmuuid string, creds *GuestCredentials) ([]*GuestProcess, error)
+	GetGuestInfo(vmuuid, key string) (string, error)
+	SetGuestInfo(vmuuid, key, value string) (bool, error)
+	ListGuestInfos(vmuuid string) (map[string]string, error)
+	Subscribe() (<-chan *Event, func())
 	PowerOn(vmuuid string) (bool, error)
 	PowerOff(vmuuid, mode string) (bool, error)
-	PowerState(vmuuid string) (bool, error)
+	PowerState(vmuuid string) (PowerState, error)
 	ShutdownGuest(vmuuid string) (bool, error)
+	Suspend(vmuuid, mode string) (bool, error)
+	Pause(vmuuid string) (bool, error)
+	Unpause(vmuuid string) (bool, error)
+	Reset(vmuuid, mode string) (bool, error)
 	Status(vmuuid string) (*VirtualMachineStatus, error)
-	WaitForIP(vmuuid string, timeout time.Duration) (string, error)
+	WaitForIP(vmuuid, network string, timeout time.Duration) (*GuestAddresses, error)
 	WaitForToolsRunning(vmuuid string, timeout time.Duration) (bool, error)
 	SetAutoStart(vmuuid string, autostart bool) (bool, error)
 	VirtualMachineByName(vmname string) (*VirtualMachine, error)
@@ -112,46 +128,79 @@ type Vmrun interface {
 	ListNetworks() ([]*NetworkDevice, error)
 	AddNetworkInterface(vmuuid, vnet string) error
 	ChangeNetworkInterface(vmuuid, vnet string, nic int) error
+	RemoveNetworkInterface(vmuuid string, nic int) error
+	ListPortForwards(vmuuid string) ([]*PortForward, error)
+	AddPortForward(vmuuid string, forward *PortForward) (*PortForward, error)
+	DeletePortForward(vmuuid, protocol string, hostPort int) (bool, error)
+	ListIPAllocations(vmnet string) ([]*IPAllocation, error)
+	AllocateIP(vmnet, owner, address string) (*IPAllocation, error)
+	ReleaseIP(vmnet, address string) (bool, error)
 	StartAutostartVM() error
+	ListSnapshots(vmuuid string) ([]*Snapshot, error)
+	TakeSnapshot(vmuuid, name, description string) (*Snapshot, error)
+	RevertToSnapshot(vmuuid, name string) (bool, error)
+	DeleteSnapshot(vmuuid, name string, deleteChildren bool) (bool, error)
+	StartPools()
+	ListPools() []*PoolStatus
+	LockVM(vmuuid string) func()
+	RLockVM(vmuuid string) func()
+	LockVmnet(name string) func()
+	RLockVmnet(name string) func()
 }
 
 type VmrunExe struct {
-	sync.Mutex
+	cacheLock       sync.RWMutex
 	exeVdiskManager string
 	exePath         string
 	logger          hclog.Logger
 	timeout         time.Duration
 	vmfolder        string
 	clonevm         bool
+	rootOwned       func(path string, andAllParents bool) bool
 	cachebyuuid     map[string]*VirtualMachine
 	cachebyvmx      map[string]*VirtualMachine
 	cachebyname     map[string]*VirtualMachine
-	client          *client.APIClient
+	client          *vmrestClient
+	pool            *vmpool
+	macs            *macAllocator
+	ipam            *ipam
+	events          *eventBus
+	running         *runningCache
+	locks           *vmLocks
 }
 
 type VirtualMachine struct {
-	Path        string `json:"path,omitempty"`
-	Uuid        string `json:"uuid,omitempty"`
-	Name        string `json:"name,omitempty"`
-	Vcpus       int    `json:"vcpus,omitempty"`
-	Memory      int    `json:"memory,omitempty"`
-	Powered     bool   `json:"powered"`
-	Address     string `json:"ip4address,omitempty"`
-	ToolsStatus string `json:"toolsStatus,omitempty"`
-}
-
-func NewVmrun(c *settings.CommonConfig, exePath, exeVdiskManager string, logger hclog.Logger) (Vmrun, error) {
-	if !vagrant_utility.RootOwned(exePath, true) {
-		return nil, errors.New("failed to locate valid vmrun executable")
+	Path        string     `json:"path,omitempty"`
+	Uuid        string     `json:"uuid,omitempty"`
+	Name        string     `json:"name,omitempty"`
+	Vcpus       int        `json:"vcpus,omitempty"`
+	Memory      int        `json:"memory,omitempty"`
+	PowerState  PowerState `json:"powerState"`
+	Address     string     `json:"ip4address,omitempty"`
+	ToolsStatus string     `json:"toolsStatus,omitempty"`
+}
+
+// Option customize the VmrunExe returned by NewVmrun
+type Option func(*VmrunExe)
+
+// WithRunningVMTTL set the lifetime of the vmrun list result shared by the power state lookups, zero disable the sharing
+func WithRunningVMTTL(ttl time.Duration) Option {
+	return func(v *VmrunExe) {
+		v.running = newRunningCache(ttl)
 	}
+}
 
-	if !vagrant_utility.RootOwned(exeVdiskManager, true) {
-		return nil, errors.New("failed to locate valid vmware-vdiskmanager executable")
+// WithRootOwned replace the check of the vmrun and vmware-vdiskmanager executables, tests use it to run fake executables
+func WithRootOwned(rootOwned func(path string, andAllParents bool) bool) Option {
+	return func(v *VmrunExe) {
+		v.rootOwned = rootOwned
 	}
+}
 
+func NewVmrun(c *settings.CommonConfig, exePath, exeVdiskManager string, logger hclog.Logger, options ...Option) (Vmrun, error) {
 	logger = logger.Named("vmrun")
 
-	return &VmrunExe{
+	vmrun := &VmrunExe{
 		exeVdiskManager: exeVdiskManager,
 		exePath:         exePath,
 		logger:          logger,
@@ -160,25 +209,97 @@ func NewVmrun(c *settings.CommonConfig, exePath, exeVdiskManager string, logger
 		cachebyuuid:     make(map[string]*VirtualMachine),
 		cachebyvmx:      make(map[string]*VirtualMachine),
 		cachebyname:     make(map[string]*VirtualMachine),
-	}, nil
+		running:         newRunningCache(runningVMTTL),
+		locks:           newVMLocks(),
+		rootOwned:       vagrant_utility.RootOwned,
+	}
+
+	for _, option := range options {
+		option(vmrun)
+	}
+
+	if !vmrun.rootOwned(exePath, true) {
+		return nil, errors.New("failed to locate valid vmrun executable")
+	}
+
+	if !vmrun.rootOwned(exeVdiskManager, true) {
+		return nil, errors.New("failed to locate valid vmware-vdiskmanager executable")
+	}
+
+	vmrun.pool = newVMPool(vmrun, c.Pools, c.ConfigFolder, logger)
+	vmrun.macs = newMacAllocator(vmrun, c.ConfigFolder, logger)
+	vmrun.ipam = newIPAM(vmrun, c.ConfigFolder, logger)
+	vmrun.events = newEventBus(vmrun, logger)
+
+	return vmrun, nil
 }
 
 func (v *VmrunExe) SetApiClient(client *client.APIClient) {
-	v.client = client
+	v.client = newVmrestClient(client)
 }
 
+// cacheVM store a copy of the VM, callers keep ownership of their own instance
 func (v *VmrunExe) cacheVM(vm *VirtualMachine) {
-	v.cachebyuuid[vm.Uuid] = vm
-	v.cachebyvmx[vm.Path] = vm
-	v.cachebyname[vm.Name] = vm
+	v.cacheLock.Lock()
+	defer v.cacheLock.Unlock()
+
+	stored := *vm
+
+	v.cachebyuuid[vm.Uuid] = &stored
+	v.cachebyvmx[vm.Path] = &stored
+	v.cachebyname[vm.Name] = &stored
 }
 
 func (v *VmrunExe) deleteCachedVM(vm *VirtualMachine) {
+	v.cacheLock.Lock()
+	defer v.cacheLock.Unlock()
+
 	delete(v.cachebyuuid, vm.Uuid)
 	delete(v.cachebyvmx, vm.Path)
 	delete(v.cachebyname, vm.Name)
 }
 
+// cachedBy return a copy of the cached VM, so it could be refreshed without locking
+func (v *VmrunExe) cachedBy(cache func() map[string]*VirtualMachine, key string) (*VirtualMachine, bool) {
+	v.cacheLock.RLock()
+	defer v.cacheLock.RUnlock()
+
+	if vm, found := cache()[key]; found {
+		copy := *vm
+
+		return &copy, true
+	}
+
+	return nil, false
+}
+
+func (v *VmrunExe) cachedByUUID(vmuuid string) (*VirtualMachine, bool) {
+	return v.cachedBy(func() map[string]*VirtualMachine { return v.cachebyuuid }, vmuuid)
+}
+
+func (v *VmrunExe) cachedByName(vmname string) (*VirtualMachine, bool) {
+	return v.cachedBy(func() map[string]*VirtualMachine { return v.cachebyname }, vmname)
+}
+
+func (v *VmrunExe) cachedByVMX(vmx string) (*VirtualMachine, bool) {
+	return v.cachedBy(func() map[string]*VirtualMachine { return v.cachebyvmx }, vmx)
+}
+
+func (v *VmrunExe) cachedVMs() []*VirtualMachine {
+	v.cacheLock.RLock()
+	defer v.cacheLock.RUnlock()
+
+	values := make([]*VirtualMachine, 0, len(v.cachebyuuid))
+
+	for _, value := range v.cachebyuuid {
+		copy := *value
+
+		values = append(values, &copy)
+	}
+
+	return values
+}
+
 func (v *VmrunExe) stillExists(vm *VirtualMachine) bool {
 	if utils.FileExists(vm.Path) {
 		if _, err := v.client.GetVM(vm.Uuid); err == nil {
@@ -193,9 +314,9 @@ func (v *VmrunExe) cachedVM(foundVM *VirtualMachine) (*VirtualMachine, error) {
 	var err error
 
 	if v.stillExists(foundVM) {
-		if foundVM.Powered, err = v.isRunningVm(foundVM); err != nil {
+		if foundVM.PowerState, err = v.powerState(foundVM); err != nil {
 			return foundVM, status.Errorf(codes.Unavailable, "failed to get power status for VM: %s, reason: %v", foundVM.Path, err)
-		} else if foundVM.Powered {
+		} else if foundVM.PowerState == PoweredOn {
 			v.vmwareToolsStatus(foundVM)
 		} else {
 			foundVM.ToolsStatus = toolsnotrunning
@@ -245,9 +366,9 @@ func (v *VmrunExe) fetchVM(vmuuid, vmx string) (vm *VirtualMachine, err error) {
 			vm.Name = name.Value
 			vm.Vcpus = info.Cpu.Processors
 			vm.Memory = info.Memory
-			vm.Powered, err = v.isRunningVm(vm)
+			vm.PowerState, err = v.powerState(vm)
 
-			if vm.Powered {
+			if vm.PowerState == PoweredOn {
 				v.vmwareToolsStatus(vm)
 			} else {
 				vm.ToolsStatus = toolsnotrunning
@@ -259,8 +380,8 @@ func (v *VmrunExe) fetchVM(vmuuid, vmx string) (vm *VirtualMachine, err error) {
 }
 
 func (v *VmrunExe) registeredVM() error {
-	v.Lock()
-	defer v.Unlock()
+	// Take a fresh power snapshot shared by all VMs of this refresh
+	v.running.invalidate()
 
 	if vms, err := v.client.GetAllVMs(); err != nil {
 		return err
@@ -279,9 +400,11 @@ func (v *VmrunExe) registeredVM() error {
 			}
 		}
 
+		v.cacheLock.Lock()
 		v.cachebyuuid = cachebyuuid
 		v.cachebyvmx = cachebyvmx
 		v.cachebyname = cachebyname
+		v.cacheLock.Unlock()
 
 		return nil
 	}
@@ -293,24 +416,12 @@ func (v *VmrunExe) RunningVms() ([]*VirtualMachine, error) {
 
 	if err := v.registeredVM(); err != nil {
 		return result, err
+	} else if running, err := v.runningVMX(); err != nil {
+		return result, err
 	} else {
-		cmd := exec.Command(v.exePath, "list")
-		exitCode, out := vagrant_utility.ExecuteWithOutput(cmd)
-
-		if exitCode != 0 {
-			v.logger.Debug(vmrunlistfailed, "exitcode", exitCode)
-			v.logger.Trace(vmrunlistfailed, "output", out)
-
-			return result, status.Errorf(codes.Internal, "failed to list running VMs")
-		}
-
-		for _, line := range strings.Split(out, "\n") {
-			line = strings.TrimSpace(line)
-
-			if vagrant_utility.FileExists(line) {
-				if vm, found := v.cachebyvmx[line]; found {
-					result = append(result, vm)
-				}
+		for vmx := range running {
+			if vm, found := v.cachedByVMX(vmx); found {
+				result = append(result, vm)
 			}
 		}
 
@@ -318,30 +429,75 @@ func (v *VmrunExe) RunningVms() ([]*VirtualMachine, error) {
 	}
 }
 
-func (v *VmrunExe) isRunningVm(vm *VirtualMachine) (bool, error) {
+// runningVMX return the vmx path of all running VMs, the result is shared for a short time
+func (v *VmrunExe) runningVMX() (map[string]bool, error) {
+	return v.running.get(v.listRunningVMX)
+}
 
-	cmd := exec.Command(v.exePath, "list")
+func (v *VmrunExe) listRunningVMX() (map[string]bool, error) {
+	return ListRunningVMX(v.exePath, v.logger)
+}
+
+// ListRunningVMX return the vmx path of all running VMs as reported by vmrun list
+func ListRunningVMX(exePath string, logger hclog.Logger) (map[string]bool, error) {
+	cmd := exec.Command(exePath, "list")
 	exitCode, out := vagrant_utility.ExecuteWithOutput(cmd)
 
 	if exitCode != 0 {
-		v.logger.Debug(vmrunlistfailed, "exitcode", exitCode)
-		v.logger.Trace(vmrunlistfailed, "output", out)
+		logger.Debug(vmrunlistfailed, "exitcode", exitCode)
+		logger.Trace(vmrunlistfailed, "output", out)
 
-		return false, status.Errorf(codes.Internal, "failed to list running VMs")
+		return nil, status.Errorf(codes.Internal, "failed to list running VMs")
 	}
 
+	running := make(map[string]bool)
+
 	for _, line := range strings.Split(out, "\n") {
-		line = strings.TrimSpace(line)
-		if line == vm.Path {
-			return true, nil
+		if line = strings.TrimSpace(line); line != "" && !strings.HasPrefix(line, "Total running VMs") {
+			running[line] = true
 		}
 	}
 
+	return running, nil
+}
+
+func (v *VmrunExe) isRunningVm(vm *VirtualMachine) (bool, error) {
+	if running, err := v.runningVMX(); err != nil {
+		return false, err
+	} else if running[vm.Path] {
+		return true, nil
+	}
+
 	v.logger.Trace("vm not running", "path", vm.Path)
 
 	return false, nil
 }
 
+func (v *VmrunExe) powerState(vm *VirtualMachine) (PowerState, error) {
+	if running, err := v.isRunningVm(vm); err != nil {
+		return PoweredOff, err
+	} else {
+		return v.powerStateOf(vm, running)
+	}
+}
+
+func (v *VmrunExe) powerStateOf(vm *VirtualMachine, running bool) (PowerState, error) {
+	if running {
+		// vmrun list doesn't make difference between running and paused VM
+		if state, err := v.client.GetPowerState(vm.Uuid); err == nil && PowerState(state.PowerState) == Paused {
+			return Paused, nil
+		}
+
+		return PoweredOn, nil
+	} else if vmx, err := utils.LoadVMX(vm.Path); err != nil {
+		return PoweredOff, err
+	} else if vmx.Get(checkpointKey) != "" {
+		return Suspended, nil
+	}
+
+	return PoweredOff, nil
+}
+
 func (v *VmrunExe) createVmPath(name string) (string, error) {
 	vmpath := utility.DirectoryForVirtualMachine(v.vmfolder, name)
 
@@ -352,48 +508,66 @@ func (v *VmrunExe) createVmPath(name string) (string, error) {
 	return vmpath, nil
 }
 
+// bootDisk return the path of the first disk of the VM, the one resized by expandDisk
+func bootDisk(vmxpath string, vmx *utils.VMXMap) (string, error) {
+	for _, controller := range []string{"nvme", "scsi", "sata"} {
+		if disk, found := utils.ReadDisk(vmx, controller, 0, 0); found && path.IsAbs(disk.FileName) {
+			return disk.FileName, nil
+		} else if found && disk.FileName != "" {
+			return path.Join(path.Dir(vmxpath), disk.FileName), nil
+		}
+	}
+
+	return "", fmt.Errorf("no disk found for vmx: %s", vmxpath)
+}
+
 func (v *VmrunExe) expandDisk(vmxpath string, diskSizeInMb int, vmx *utils.VMXMap) error {
 
 	if diskSizeInMb == 0 {
 		return nil
 	}
 
-	for _, disk := range []string{"nvme0:0", "scsi0:0", "sata0:0"} {
-		key := fmt.Sprintf("%s.present", disk)
-
-		if utils.StrToBool(vmx.Get(key)) {
-			key = fmt.Sprintf("%s.filename", disk)
+	vmdk, err := bootDisk(vmxpath, vmx)
 
-			if vmx.Has(key) {
-				vmdk := path.Join(path.Dir(vmxpath), vmx.Get(key))
-
-				if _, err := os.Stat(vmdk); err != nil {
-					return status.Errorf(codes.AlreadyExists, "VMDK: %s not found", vmdk)
-				}
+	if err != nil {
+		return err
+	}
 
-				cmd := exec.Command(v.exeVdiskManager, "-x", fmt.Sprintf("%dMB", diskSizeInMb), vmdk)
-				exitCode, out := vagrant_utility.ExecuteWithOutput(cmd)
+	if _, err := os.Stat(vmdk); err != nil {
+		return status.Errorf(codes.AlreadyExists, "VMDK: %s not found", vmdk)
+	}
 
-				if exitCode != 0 && !strings.Contains(out, "One of the parameters supplied is invalid") {
-					v.logger.Debug("vmware-vdiskmanager failed", "exitcode", exitCode)
-					v.logger.Trace("vmware-vdiskmanager failed", "output", out)
+	cmd := exec.Command(v.exeVdiskManager, "-x", fmt.Sprintf("%dMB", diskSizeInMb), vmdk)
+	exitCode, out := vagrant_utility.ExecuteWithOutput(cmd)
 
-					return status.Errorf(codes.Internal, "failed to expand VMDK: %s to %dM, reason: %s", vmdk, diskSizeInMb, out)
-				}
+	// The disk of the template is already larger than the requested size
+	if exitCode != 0 && !strings.Contains(out, "One of the parameters supplied is invalid") {
+		v.logger.Debug("vmware-vdiskmanager failed", "exitcode", exitCode)
+		v.logger.Trace("vmware-vdiskmanager failed", "output", out)
 
-				return nil
-			}
-		}
+		return status.Errorf(codes.Internal, "failed to expand VMDK: %s to %dM, reason: %s", vmdk, diskSizeInMb, out)
 	}
 
-	return fmt.Errorf("no disk found for vmx: %s", vmxpath)
+	return nil
 }
 
-func (v *VmrunExe) clone(template *VirtualMachine, name string) (newpath string, err error) {
+func (v *VmrunExe) clone(template *VirtualMachine, name string, linked bool) (newpath string, err error) {
 	if newpath, err = v.createVmPath(name); err != nil {
 		return newpath, err
 	} else {
-		cmd := exec.Command(v.exePath, "clone", template.Path, newpath, "full", fmt.Sprintf("-cloneName=%s", name))
+		args := []string{"clone", template.Path, newpath, "full"}
+
+		if linked {
+			var snapshot string
+
+			if snapshot, err = v.linkedSnapshot(template); err != nil {
+				return newpath, err
+			}
+
+			args = []string{"clone", template.Path, newpath, "linked", fmt.Sprintf("-snapshot=%s", snapshot)}
+		}
+
+		cmd := exec.Command(v.exePath, append(args, fmt.Sprintf("-cloneName=%s", name))...)
 		exitCode, out := vagrant_utility.ExecuteWithOutput(cmd)
 
 		if exitCode != 0 {
@@ -407,64 +581,69 @@ func (v *VmrunExe) clone(template *VirtualMachine, name string) (newpath string,
 	}
 }
 
-func (v *VmrunExe) prepareEthernet(vmx *utils.VMXMap, inf *NetworkInterface, card int) {
+func (v *VmrunExe) prepareEthernet(vmx *utils.VMXMap, inf *NetworkInterface, card int) error {
 	darwin := vagrant_utility.IsBigSurMin()
+	pciSlotNumber, err := utils.EthernetPCISlot(vmx, card)
 
-	ethernet := fmt.Sprintf("ethernet%d.", card)
+	if err != nil {
+		return err
+	}
 
-	vmx.Set(ethernet+"present", "TRUE")
-	vmx.Set(ethernet+"virtualDev", inf.Device)
-	vmx.Set(ethernet+"connectionType", inf.ConnectionType)
-	vmx.Set(ethernet+"linkStatePropagation.enable", "TRUE")
-	vmx.Set(ethernet+"pciSlotNumber", pcislotnumber[card])
+	ethernet := &utils.EthernetDevice{
+		Index:                card,
+		Present:              true,
+		VirtualDev:           inf.Device,
+		ConnectionType:       inf.ConnectionType,
+		LinkStatePropagation: true,
+		PciSlotNumber:        pciSlotNumber,
+	}
 
 	if darwin {
-		if inf.BsdName != "" {
-			vmx.Set(ethernet+"bsdName", inf.BsdName)
-		}
-
-		if inf.DisplayName != "" {
-			vmx.Set(ethernet+"displayName", inf.DisplayName)
-		}
+		ethernet.BsdName = inf.BsdName
+		ethernet.DisplayName = inf.DisplayName
 	}
 
 	if inf.ConnectionType == "custom" {
 		if darwin {
-			vmx.Set(ethernet+"vnet", inf.Vnet)
+			ethernet.Vnet = inf.Vnet
 		} else {
-			vmx.Set(ethernet+"vnet", "/dev/"+inf.Vnet)
+			ethernet.Vnet = "/dev/" + inf.Vnet
 		}
 	}
 
-	vmx.Delete(ethernet + "generatedAddress")
-	vmx.Delete(ethernet + "generatedAddressOffset")
-
-	if inf.MacAddress != "generated" {
-		vmx.Set(ethernet+"addressType", "static")
-		vmx.Set(ethernet+"address", inf.MacAddress)
+	if inf.MacAddress != "" && inf.MacAddress != MacAddressGenerated {
+		ethernet.AddressType = "static"
+		ethernet.Address = inf.MacAddress
 	} else {
-		vmx.Set(ethernet+"addressType", inf.MacAddress)
+		ethernet.AddressType = "generated"
 	}
-}
 
-func (v *VmrunExe) prepareNetworkInterface(request *CreateVirtualMachine, vmx *utils.VMXMap) {
-	numCards := len(request.Networks)
+	return ethernet.Write(vmx)
+}
 
-	if len(pcislotnumber) < numCards {
-		numCards = len(pcislotnumber)
+func (v *VmrunExe) prepareNetworkInterface(request *CreateVirtualMachine, vmx *utils.VMXMap) error {
+	if len(request.Networks) > utils.MaxEthernetCards {
+		return fmt.Errorf("too many network interfaces: %d, VMware supports %d", len(request.Networks), utils.MaxEthernetCards)
 	}
 
-	for card := 0; card < numCards; card++ {
-		v.prepareEthernet(vmx, request.Networks[card], card)
+	for card := range request.Networks {
+		if err := v.prepareEthernet(vmx, request.Networks[card], card); err != nil {
+			return err
+		}
 	}
+
+	return nil
 }
 
-func (v *VmrunExe) prepareVMX(request *CreateVirtualMachine, vmxpath string, vmx *utils.VMXMap) (string, error) {
+// editVMX apply the create request to the VMX of the clone
+func (v *VmrunExe) editVMX(request *CreateVirtualMachine, vmx *utils.VMXMap) error {
 	vmx.Cleanup(len(request.Networks) > 0)
 
+	if err := resizeVMX(vmx, request.Vcpus, request.Memory); err != nil {
+		return err
+	}
+
 	vmx.Set(vmnameKey, request.Name)
-	vmx.Set(numcpusKey, strconv.Itoa(request.Vcpus))
-	vmx.Set(memsizeKey, strconv.Itoa(request.Memory))
 	vmx.Set(autostartKey, utils.BoolToStr(request.Autostart))
 
 	// Set new guest infos
@@ -474,9 +653,13 @@ func (v *VmrunExe) prepareVMX(request *CreateVirtualMachine, vmxpath string, vmx
 		}
 	}
 
-	v.prepareNetworkInterface(request, vmx)
+	return v.prepareNetworkInterface(request, vmx)
+}
 
-	if err := vmx.Save(vmxpath); err != nil {
+func (v *VmrunExe) prepareVMX(request *CreateVirtualMachine, vmxpath string, vmx *utils.VMXMap) (string, error) {
+	if err := v.editVMX(request, vmx); err != nil {
+		return "", err
+	} else if err = vmx.Save(vmxpath); err != nil {
 		return "", err
 	}
 
@@ -502,22 +685,10 @@ func (v *VmrunExe) prepareVM(request *CreateVirtualMachine, vm *VirtualMachine)
 		return status.Errorf(codes.FailedPrecondition, "failed to load VMX: %s, reason: %v", vm.Path, err)
 	}
 
-	vmx.Cleanup(len(request.Networks) > 0)
-
-	vmx.Set(vmnameKey, request.Name)
-	vmx.Set(numcpusKey, strconv.Itoa(request.Vcpus))
-	vmx.Set(memsizeKey, strconv.Itoa(request.Memory))
-	vmx.Set(autostartKey, utils.BoolToStr(request.Autostart))
-
-	// Set new guest infos
-	if request.GuestInfos != nil {
-		for k, v := range request.GuestInfos {
-			vmx.Set(guestinfoKey+k, v)
-		}
+	if err = v.editVMX(request, vmx); err != nil {
+		return status.Errorf(codes.InvalidArgument, "failed to prepare network interfaces: %s, reason: %v", vm.Path, err)
 	}
 
-	v.prepareNetworkInterface(request, vmx)
-
 	if err = vmx.Save(vm.Path); err != nil {
 		return status.Errorf(codes.FailedPrecondition, "failed to save VMX: %s, reason: %v", vm.Path, err)
 	}
@@ -537,10 +708,42 @@ func (v *VmrunExe) prepareVM(request *CreateVirtualMachine, vm *VirtualMachine)
 }
 
 func (v *VmrunExe) Create(request *CreateVirtualMachine) (*VirtualMachine, error) {
-	v.Lock()
-	defer v.Unlock()
+	defer v.locks.lock(nameLockKey(request.Name))()
+
+	// Linked clone could take the base snapshot on the template
+	if request.Linked {
+		defer v.locks.lock(request.Template)()
+	} else {
+		defer v.locks.rlock(request.Template)()
+	}
+
+	if _, err := v.VirtualMachineByName(request.Name); err == nil {
+		return nil, status.Errorf(codes.AlreadyExists, "VM named: %s, already exists", request.Name)
+	} else if request, err = v.allocateMacAddresses(request, true); err != nil {
+		return nil, err
+	} else if reservations, err := v.requestedReservations(request); err != nil {
+		return nil, err
+	} else if err = v.reserveAddresses(reservations); err != nil {
+		return nil, err
+	} else if vm, err := v.create(request); err != nil {
+		v.releaseAddresses(reservations)
+
+		return nil, err
+	} else {
+		return vm, nil
+	}
+}
+
+func (v *VmrunExe) create(request *CreateVirtualMachine) (*VirtualMachine, error) {
+	if request.Linked {
+		// vmrest can't create linked clone, the clone must be registered to be found
+		linked := *request
+		linked.Register = linked.Register || !v.clonevm
 
-	if v.clonevm {
+		return v.createWithVMRun(&linked)
+	} else if vm, err := v.createFromPool(request); err != nil || vm != nil {
+		return vm, err
+	} else if v.clonevm {
 		return v.createWithVMRun(request)
 	} else {
 		return v.createWithVMRest(request)
@@ -572,27 +775,55 @@ func (v *VmrunExe) createWithVMRest(request *CreateVirtualMachine) (*VirtualMach
 }
 
 func (v *VmrunExe) createWithVMRun(request *CreateVirtualMachine) (*VirtualMachine, error) {
-	if _, err := v.VirtualMachineByName(request.Name); err == nil {
+	if request.Linked && request.DiskSizeInMb > 0 {
+		// vmware-vdiskmanager can't expand the delta disk of a linked clone
+		return nil, status.Errorf(codes.InvalidArgument, "the disk of the linked clone: %s, can't be resized", request.Name)
+	} else if _, err := v.VirtualMachineByName(request.Name); err == nil {
 		return nil, status.Errorf(codes.AlreadyExists, "VM named: %s, already exists", request.Name)
 	} else if template, err := v.VirtualMachineByUUID(request.Template); err != nil {
 		return nil, status.Errorf(codes.NotFound, "Template: %s, not found", request.Template)
-	} else if vmxpath, err := v.clone(template, request.Name); err != nil {
+	} else if vmxpath, err := v.clone(template, request.Name, request.Linked); err != nil {
 		return nil, status.Errorf(codes.FailedPrecondition, "failed to clone VM: %s, reason: %v", template.Path, err)
-	} else if vmx, err := utils.LoadVMX(vmxpath); err != nil {
-		return nil, status.Errorf(codes.FailedPrecondition, "failed to load VMX: %s, reason: %v", vmxpath, err)
-	} else if vmuuid, err := v.prepareVMX(request, vmxpath, vmx); err != nil {
-		return nil, status.Errorf(codes.FailedPrecondition, "failed to prepare VMX: %s, reason: %v", template.Path, err)
-	} else if err = v.expandDisk(vmxpath, request.DiskSizeInMb, vmx); err != nil {
-		return nil, status.Errorf(codes.FailedPrecondition, "failed to expand disk of VM: %s, reason: %v", template.Path, err)
+	} else if vmuuid, err := v.prepareClone(request, template, vmxpath); err != nil {
+		return nil, err
 	} else {
 		return v.VirtualMachineByUUID(vmuuid)
 	}
 }
 
+// prepareClone edit the VMX and expand the disk of a new clone. The clone is removed on failure,
+// otherwise every retry with the same name would fail on the existing directory.
+func (v *VmrunExe) prepareClone(request *CreateVirtualMachine, template *VirtualMachine, vmxpath string) (vmuuid string, err error) {
+	var vmx *utils.VMXMap
+
+	if vmx, err = utils.LoadVMX(vmxpath); err != nil {
+		err = status.Errorf(codes.FailedPrecondition, "failed to load VMX: %s, reason: %v", vmxpath, err)
+	} else if vmuuid, err = v.prepareVMX(request, vmxpath, vmx); err != nil {
+		err = status.Errorf(codes.FailedPrecondition, "failed to prepare VMX: %s, reason: %v", template.Path, err)
+	} else if err = v.expandDisk(vmxpath, request.DiskSizeInMb, vmx); err != nil {
+		err = status.Errorf(codes.FailedPrecondition, "failed to expand disk of VM: %s, reason: %v", template.Path, err)
+
+		// prepareVMX return the template uuid when the clone isn't registered
+		if request.Register {
+			if e := v.client.DeleteVM(vmuuid); e != nil {
+				v.logger.Warn("unable to delete registered clone", "vmuuid", vmuuid, "path", vmxpath, "error", e)
+			}
+		}
+	} else {
+		return vmuuid, nil
+	}
+
+	if e := os.RemoveAll(filepath.Dir(vmxpath)); e != nil {
+		v.logger.Warn("unable to remove clone", "path", vmxpath, "error", e)
+	}
+
+	return "", err
+}
+
 func (v *VmrunExe) deleteWithVMRun(vmuuid string) (bool, error) {
 	if found, err := v.VirtualMachineByUUID(vmuuid); err != nil {
 		return false, status.Errorf(codes.NotFound, failedtofindvm, vmuuid, err)
-	} else if found.Powered {
+	} else if found.PowerState.Powered() {
 		return false, status.Errorf(codes.FailedPrecondition, "failed to delete VM: %s, reason: powered", vmuuid)
 	} else {
 		v.deleteCachedVM(found)
@@ -600,12 +831,18 @@ func (v *VmrunExe) deleteWithVMRun(vmuuid string) (bool, error) {
 		cmd := exec.Command(v.exePath, "deleteVM", found.Path)
 		exitCode, out := vagrant_utility.ExecuteWithOutput(cmd)
 
+		v.running.invalidate()
+
 		if exitCode != 0 {
 			v.logger.Debug("vmrun deleteVM failed", "exitcode", exitCode)
 			v.logger.Trace("vmrun deleteVM failed", "output", out)
 
 			return false, status.Errorf(codes.Internal, "failed to delete VM: %s, reason: %s", vmuuid, out)
 		}
+
+		utils.RemoveVMXBackups(found.Path)
+
+		v.prunePortForwards(vmuuid)
 	}
 
 	return true, nil
@@ -614,33 +851,55 @@ func (v *VmrunExe) deleteWithVMRun(vmuuid string) (bool, error) {
 func (v *VmrunExe) deleteWithVMRest(vmuuid string) (bool, error) {
 	if found, err := v.VirtualMachineByUUID(vmuuid); err != nil {
 		return false, status.Errorf(codes.NotFound, failedtofindvm, vmuuid, err)
-	} else if found.Powered {
+	} else if found.PowerState.Powered() {
 		return false, status.Errorf(codes.FailedPrecondition, "failed to delete VM: %s, reason: powered", vmuuid)
 	} else if err = v.client.DeleteVM(vmuuid); err != nil {
 		return false, status.Errorf(codes.Internal, "failed to delete VM: %s, reason: %v", vmuuid, err)
 	} else {
 		v.deleteCachedVM(found)
+
+		utils.RemoveVMXBackups(found.Path)
+
+		v.prunePortForwards(vmuuid)
 	}
 
 	return true, nil
 }
 
 func (v *VmrunExe) Delete(vmuuid string) (bool, error) {
-	v.Lock()
-	defer v.Unlock()
+	defer v.locks.lock(vmuuid)()
 
-	if v.clonevm {
-		return v.deleteWithVMRun(vmuuid)
+	if found, err := v.VirtualMachineByUUID(vmuuid); err != nil {
+		return false, status.Errorf(codes.NotFound, failedtofindvm, vmuuid, err)
+	} else if children, err := v.linkedChildren(found); err != nil {
+		return false, err
+	} else if len(children) > 0 {
+		return false, status.Errorf(codes.FailedPrecondition, "failed to delete VM: %s, reason: still used by linked clones: %s", vmuuid, strings.Join(children, ", "))
 	} else {
-		return v.deleteWithVMRest(vmuuid)
+		// The MAC addresses are read before the VMX is gone
+		reservations := v.vmReservations(found)
+		deleted := false
+
+		if v.clonevm {
+			deleted, err = v.deleteWithVMRun(vmuuid)
+		} else {
+			deleted, err = v.deleteWithVMRest(vmuuid)
+		}
+
+		if deleted {
+			v.releaseAddresses(reservations)
+			v.ipam.reclaim(found.Name)
+		}
+
+		return deleted, err
 	}
 }
 
-func (v *VmrunExe) PowerState(vmuuid string) (bool, error) {
+func (v *VmrunExe) PowerState(vmuuid string) (PowerState, error) {
 	if found, err := v.VirtualMachineByUUID(vmuuid); err != nil {
-		return false, status.Errorf(codes.NotFound, failedtofindvm, vmuuid, err)
+		return PoweredOff, status.Errorf(codes.NotFound, failedtofindvm, vmuuid, err)
 	} else {
-		return found.Powered, nil
+		return found.PowerState, nil
 	}
 }
 
@@ -648,6 +907,8 @@ func (v *VmrunExe) powerOnVM(vm *VirtualMachine) error {
 	cmd := exec.Command(v.exePath, "start", vm.Path, "nogui")
 	exitCode, out := vagrant_utility.ExecuteWithOutput(cmd)
 
+	v.running.invalidate()
+
 	if exitCode != 0 {
 		v.logger.Debug("vmrun start failed", "exitcode", exitCode)
 		v.logger.Trace("vmrun start failed", "output", out)
@@ -655,19 +916,20 @@ func (v *VmrunExe) powerOnVM(vm *VirtualMachine) error {
 		return status.Errorf(codes.Internal, "failed to power on VM: %s, reason: %s", vm.Uuid, out)
 	}
 
-	vm.Powered = true
+	vm.PowerState = PoweredOn
 
 	return nil
 }
 
 func (v *VmrunExe) PowerOn(vmuuid string) (bool, error) {
-	v.Lock()
-	defer v.Unlock()
+	defer v.locks.lock(vmuuid)()
 
 	if found, err := v.VirtualMachineByUUID(vmuuid); err != nil {
 		return false, status.Errorf(codes.NotFound, failedtofindvm, vmuuid, err)
-	} else if found.Powered {
+	} else if found.PowerState == PoweredOn {
 		return true, nil
+	} else if found.PowerState == Paused {
+		return v.changePowerState(found, PoweredOn, "unpause")
 	} else if err = v.powerOnVM(found); err != nil {
 		return false, err
 	}
@@ -676,17 +938,18 @@ func (v *VmrunExe) PowerOn(vmuuid string) (bool, error) {
 }
 
 func (v *VmrunExe) PowerOff(vmuuid, mode string) (bool, error) {
-	v.Lock()
-	defer v.Unlock()
+	defer v.locks.lock(vmuuid)()
 
 	if found, err := v.VirtualMachineByUUID(vmuuid); err != nil {
 		return false, status.Errorf(codes.NotFound, failedtofindvm, vmuuid, err)
-	} else if !found.Powered {
+	} else if !found.PowerState.Powered() {
 		return true, nil
 	} else {
 		cmd := exec.Command(v.exePath, "stop", found.Path, mode)
 		exitCode, out := vagrant_utility.ExecuteWithOutput(cmd)
 
+		v.running.invalidate()
+
 		if exitCode != 0 {
 			v.logger.Debug(vmrunstopfailed, "exitcode", exitCode)
 			v.logger.Trace(vmrunstopfailed, "output", out)
@@ -694,30 +957,33 @@ func (v *VmrunExe) PowerOff(vmuuid, mode string) (bool, error) {
 			return false, status.Errorf(codes.Internal, "failed to power off VM: %s, reason: %s", vmuuid, out)
 		}
 
-		found.Powered = true
+		found.PowerState = PoweredOff
 	}
 
 	return true, nil
 }
 
 func (v *VmrunExe) ShutdownGuest(vmuuid string) (bool, error) {
-	v.Lock()
-	defer v.Unlock()
+	defer v.locks.lock(vmuuid)()
 
 	if found, err := v.VirtualMachineByUUID(vmuuid); err != nil {
 		return false, status.Errorf(codes.NotFound, failedtofindvm, vmuuid, err)
-	} else if !found.Powered {
+	} else if !found.PowerState.Powered() {
 		return true, nil
 	} else {
 		cmd := exec.Command(v.exePath, "stop", found.Path, "soft")
 		exitCode, out := vagrant_utility.ExecuteWithOutput(cmd)
 
+		v.running.invalidate()
+
 		if exitCode != 0 {
 			v.logger.Debug(vmrunstopfailed, "exitcode", exitCode)
 			v.logger.Trace(vmrunstopfailed, "output", out)
 
 			return false, status.Errorf(codes.Internal, "failed to shutdown VM: %s, reason: %s", vmuuid, out)
 		}
+
+		found.PowerState = PoweredOff
 	}
 
 	return true, nil
@@ -788,7 +1054,7 @@ func (v *VmrunExe) getNicInfoNotPowered(vm *VirtualMachine) (infos []networkInfo
 
 func (v *VmrunExe) getNicInfo(vm *VirtualMachine) (infos []networkInfo, err error) {
 
-	if vm.Powered {
+	if vm.PowerState.Powered() {
 		return v.getNicInfoPowered(vm)
 	} else {
 		return v.getNicInfoNotPowered(vm)
@@ -803,90 +1069,77 @@ func (v *VmrunExe) Status(vmuuid string) (*VirtualMachineStatus, error) {
 	} else if nics, err := v.getNicInfo(vm); err != nil {
 		return nil, status.Errorf(codes.FailedPrecondition, "can't get nics for vm %s, reason: %v", vm.Path, err)
 	} else {
-		card := 0
+		ethernets := utils.ReadEthernets(vmx)
 
 		vmstatus := &VirtualMachineStatus{
-			Powered:       vm.Powered,
-			EthernetCards: make([]*EthernetCard, 0, 5),
+			PowerState:    vm.PowerState,
+			EthernetCards: make([]*EthernetCard, 0, len(ethernets)),
 		}
 
-		for {
-			key := fmt.Sprintf("ethernet%d.present", card)
-
-			if vmx.Has(key) {
-				var macaddress string
-
-				present := vmx.Get(fmt.Sprintf("ethernet%d.present", card))
-				addressType := vmx.Get(fmt.Sprintf("ethernet%d.addresstype", card))
-
-				if addressType == "generated" {
-					macaddress = vmx.Get(fmt.Sprintf("ethernet%d.generatedaddress", card))
-				} else {
-					macaddress = vmx.Get(fmt.Sprintf("ethernet%d.address", card))
-				}
-
-				ethernet := &EthernetCard{
-					Present:              utils.StrToBool(present),
-					IP4Address:           v.getNicAddress(macaddress, nics),
-					AddressType:          addressType,
-					BsdName:              vmx.Get(fmt.Sprintf("ethernet%d.bsdname", card)),
-					ConnectionType:       vmx.Get(fmt.Sprintf("ethernet%d.connectiontype", card)),
-					DisplayName:          vmx.Get(fmt.Sprintf("ethernet%d.displayname", card)),
-					MacAddress:           macaddress,
-					MacAddressOffset:     utils.StrToInt(vmx.Get(fmt.Sprintf("ethernet%d.generatedaddressoffset", card))),
-					LinkStatePropagation: utils.StrToBool(vmx.Get(fmt.Sprintf("ethernet%d.linkstatepropagation.enable", card))),
-					PciSlotNumber:        utils.StrToInt(vmx.Get(fmt.Sprintf("ethernet%d.pcislotnumber", card))),
-					VirtualDev:           vmx.Get(fmt.Sprintf("ethernet%d.virtualdev", card)),
-					Vnet:                 vmx.Get(fmt.Sprintf("ethernet%d.vnet", card)),
-				}
-
-				card++
-
-				vmstatus.EthernetCards = append(vmstatus.EthernetCards, ethernet)
-			} else {
-				break
-			}
+		for _, ethernet := range ethernets {
+			macaddress := ethernet.MacAddress()
+
+			vmstatus.EthernetCards = append(vmstatus.EthernetCards, &EthernetCard{
+				Present:              ethernet.Present,
+				IP4Address:           v.getNicAddress(macaddress, nics),
+				AddressType:          ethernet.AddressType,
+				BsdName:              ethernet.BsdName,
+				ConnectionType:       ethernet.ConnectionType,
+				DisplayName:          ethernet.DisplayName,
+				MacAddress:           macaddress,
+				MacAddressOffset:     ethernet.GeneratedAddressOffset,
+				LinkStatePropagation: ethernet.LinkStatePropagation,
+				PciSlotNumber:        ethernet.PciSlotNumber,
+				VirtualDev:           ethernet.VirtualDev,
+				Vnet:                 ethernet.Vnet,
+			})
 		}
 
 		return vmstatus, nil
 	}
 }
 
-func (v *VmrunExe) WaitForIP(vmuuid string, timeout time.Duration) (string, error) {
-	if vm, err := v.VirtualMachineByUUID(vmuuid); err != nil {
-		return "", err
-	} else if !vm.Powered {
-		return "", status.Errorf(codes.FailedPrecondition, "failed to wait for IP, VM: %s is not powered", vmuuid)
+// WaitForIP wait until the guest report an address. The network select the awaited interface by its index,
+// its MAC address or a CIDR, if empty the address reported by vmrun is awaited. Addresses of every interface are returned.
+func (v *VmrunExe) WaitForIP(vmuuid, network string, timeout time.Duration) (*GuestAddresses, error) {
+	if selector, err := parseNicSelector(network); err != nil {
+		return nil, err
+	} else if vm, err := v.VirtualMachineByUUID(vmuuid); err != nil {
+		return nil, err
+	} else if vm.PowerState != PoweredOn {
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to wait for IP, VM: %s is not powered", vmuuid)
 	} else {
-		address := ""
+		result := &GuestAddresses{}
 
 		err = utils.PollImmediate(5*time.Second, timeout, func() (done bool, err error) {
-			if ipaddress, _ := v.client.GetIPAddress(vmuuid); ipaddress != nil && len(ipaddress.Ip) > 0 {
-				address = ipaddress.Ip
-				return true, nil
-			} else {
-				cmd := exec.Command(v.exePath, "getGuestIPAddress", vm.Path)
-				exitCode, out := vagrant_utility.ExecuteWithOutput(cmd)
-
-				if exitCode != 0 {
-					// Got it on linux
-					if strings.HasPrefix(out, "Error: Unable to get the IP address") || strings.HasPrefix(out, "Error: Cannot open VM:") || strings.HasPrefix(out, "Error: The VMware Tools are not running in the virtual machine") {
-						return false, nil
-					}
+			if selector == nil {
+				if result.Address, done, err = v.guestIPAddress(vm); done {
+					result.Interfaces, _ = v.guestInterfaces(vm, false)
+				}
 
-					v.logger.Debug("vmrun getGuestIPAddress failed", "exitcode", exitCode)
-					v.logger.Trace("vmrun getGuestIPAddress failed", "output", out)
+				return done, err
+			} else if interfaces, err := v.guestInterfaces(vm, selector.index != 0); err != nil {
+				v.logger.Debug("unable to get guest interfaces", "vmuuid", vmuuid, "error", err)
 
-					return false, status.Errorf(codes.Internal, "failed to get ip VM: %s, reason: %s", vmuuid, out)
+				// Without the NIC devices an index never match
+				if selector.index != 0 {
+					return false, err
 				}
+			} else {
+				for _, inf := range interfaces {
+					if address := selector.address(inf); address != "" {
+						result.Address = address
+						result.Interfaces = interfaces
 
-				address = strings.Trim(out, "\n")
-
-				return true, nil
+						return true, nil
+					}
+				}
 			}
+
+			return false, nil
 		})
 
-		return address, err
+		return result, err
 	}
 }
 
@@ -920,7 +1173,7 @@ func (v *VmrunExe) vmwareToolsStatus(vm *VirtualMachine) error {
 func (v *VmrunExe) WaitForToolsRunning(vmuuid string, timeout time.Duration) (bool, error) {
 	if vm, err := v.VirtualMachineByUUID(vmuuid); err != nil {
 		return false, err
-	} else if !vm.Powered {
+	} else if vm.PowerState != PoweredOn {
 		return false, status.Errorf(codes.FailedPrecondition, "failed to wait for IP, VM: %s is not powered", vmuuid)
 	} else {
 		result := false
@@ -951,8 +1204,12 @@ func (v *VmrunExe) StartAutostartVM() error {
 		return err
 	} else {
 		for _, vm := range vms {
-			if param, _ := v.client.GetVMParams(vm.Uuid, "autostart"); !vm.Powered && utils.StrToBool(param.Value) {
-				if err = v.powerOnVM(vm); err != nil {
+			if param, _ := v.client.GetVMParams(vm.Uuid, "autostart"); !vm.PowerState.Powered() && utils.StrToBool(param.Value) {
+				unlock := v.locks.lock(vm.Uuid)
+				err = v.powerOnVM(vm)
+				unlock()
+
+				if err != nil {
 					v.logger.Error(fmt.Sprintf("unable to autostart VM: %s, %s", vm.Uuid, vm.Name))
 				} else {
 					v.logger.Info(fmt.Sprintf("Started VM: %s, %s", vm.Uuid, vm.Name))
@@ -965,6 +1222,8 @@ func (v *VmrunExe) StartAutostartVM() error {
 }
 
 func (v *VmrunExe) SetAutoStart(vmuuid string, autostart bool) (bool, error) {
+	defer v.locks.lock(vmuuid)()
+
 	if vm, err := v.VirtualMachineByUUID(vmuuid); err != nil {
 		return false, err
 	} else if vmx, err := utils.LoadVMX(vm.Path); err != nil {
@@ -988,9 +1247,7 @@ func (v *VmrunExe) findVM(vmname string) (*VirtualMachine, error) {
 			if name, err := v.client.GetVMParams(vm.Id, vmnameKey); err == nil {
 				if name.Value == vmname {
 					if foundVM, err := v.fetchVM(vm.Id, vm.Path); err == nil {
-						v.cachebyuuid[vm.Id] = foundVM
-						v.cachebyvmx[vm.Path] = foundVM
-						v.cachebyvmx[foundVM.Name] = foundVM
+						v.cacheVM(foundVM)
 
 						return foundVM, nil
 					}
@@ -1005,7 +1262,7 @@ func (v *VmrunExe) findVM(vmname string) (*VirtualMachine, error) {
 func (v *VmrunExe) VirtualMachineByName(vmname string) (foundVM *VirtualMachine, err error) {
 	var found bool
 
-	if foundVM, found = v.cachebyname[vmname]; !found {
+	if foundVM, found = v.cachedByName(vmname); !found {
 		return v.findVM(vmname)
 	} else if foundVM, err = v.cachedVM(foundVM); err != nil {
 		return nil, err
@@ -1037,7 +1294,7 @@ func (v *VmrunExe) fetchAndCacheVM(vmuuid string) (foundVM *VirtualMachine, err
 func (v *VmrunExe) VirtualMachineByUUID(vmuuid string) (foundVM *VirtualMachine, err error) {
 	var found bool
 
-	if foundVM, found = v.cachebyuuid[vmuuid]; !found {
+	if foundVM, found = v.cachedByUUID(vmuuid); !found {
 
 		if foundVM, err = v.fetchAndCacheVM(vmuuid); err != nil {
 			return nil, err
@@ -1056,13 +1313,7 @@ func (v *VmrunExe) ListVirtualMachines() ([]*VirtualMachine, error) {
 	if err := v.registeredVM(); err != nil {
 		return nil, err
 	} else {
-		values := make([]*VirtualMachine, 0, len(v.cachebyuuid))
-
-		for _, value := range v.cachebyuuid {
-			values = append(values, value)
-		}
-
-		return values, nil
+		return v.cachedVMs(), nil
 	}
 }
 
@@ -1085,19 +1336,54 @@ func (v *VmrunExe) setCustomInterface(vm *VirtualMachine, vmnet string, inetInde
 		return nil, err
 	} else {
 		inf := &NetworkInterface{
-			MacAddress:     "generated",
+			MacAddress:     MacAddressGenerated,
 			Vnet:           vmnet,
 			ConnectionType: "custom",
 			Device:         "vmxnet3",
 		}
 
-		v.prepareEthernet(vmx, inf, inetIndex)
+		if err = v.prepareEthernet(vmx, inf, inetIndex); err != nil {
+			return nil, err
+		}
 
 		return vmx, nil
 	}
 }
 
+// setEthernetVirtualDev change the adapter model of a card created by vmrest
+func setEthernetVirtualDev(vmx *utils.VMXMap, card int, virtualDev string) error {
+	if ethernet, found := utils.ReadEthernet(vmx, card); !found {
+		return status.Errorf(codes.NotFound, "network interface: ethernet%d not found", card)
+	} else {
+		ethernet.VirtualDev = virtualDev
+
+		if err := ethernet.Write(vmx); err != nil {
+			return status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+
+		return nil
+	}
+}
+
+// freeEthernetCard return the first unused card, removed interfaces leave holes in the numbering
+func freeEthernetCard(nics []model.NicDevice) int {
+	used := make(map[int]bool)
+
+	for _, nic := range nics {
+		used[nic.Index-1] = true
+	}
+
+	for card := 0; card < utils.MaxEthernetCards; card++ {
+		if !used[card] {
+			return card
+		}
+	}
+
+	return -1
+}
+
 func (v *VmrunExe) AddNetworkInterface(vmuuid, vmnet string) error {
+	defer v.locks.lock(vmuuid)()
 
 	if found, err := v.VirtualMachineByUUID(vmuuid); err != nil {
 		return err
@@ -1108,8 +1394,11 @@ func (v *VmrunExe) AddNetworkInterface(vmuuid, vmnet string) error {
 	} else {
 		var vmx *utils.VMXMap
 
-		inetIndex := len(nics.Nics)
-		if network.Type == "bridged" && vmnet != "vmnet0" {
+		inetIndex := freeEthernetCard(nics.Nics)
+
+		if inetIndex < 0 {
+			return status.Errorf(codes.FailedPrecondition, "failed to add network interface to VM: %s, reason: %d interfaces already", vmuuid, utils.MaxEthernetCards)
+		} else if network.Type == "bridged" && vmnet != "vmnet0" {
 			network.Type = "custom"
 		}
 
@@ -1121,8 +1410,8 @@ func (v *VmrunExe) AddNetworkInterface(vmuuid, vmnet string) error {
 			return err
 		} else if vmx, err = utils.LoadVMX(found.Path); err != nil {
 			return err
-		} else {
-			vmx.Set(fmt.Sprintf("ethernet%d.virtualDev", nic.Index-1), "vmxnet3")
+		} else if err = setEthernetVirtualDev(vmx, nic.Index-1, "vmxnet3"); err != nil {
+			return err
 		}
 
 		return vmx.Save(found.Path)
@@ -1130,6 +1419,8 @@ func (v *VmrunExe) AddNetworkInterface(vmuuid, vmnet string) error {
 }
 
 func (v *VmrunExe) ChangeNetworkInterface(vmuuid, vmnet string, nic int) error {
+	defer v.locks.lock(vmuuid)()
+
 	if found, err := v.VirtualMachineByUUID(vmuuid); err != nil {
 		return err
 	} else if network, err := v.getNetworkInfos(vmnet); err != nil {
@@ -1150,14 +1441,31 @@ func (v *VmrunExe) ChangeNetworkInterface(vmuuid, vmnet string, nic int) error {
 			return err
 		} else if vmx, err = utils.LoadVMX(found.Path); err != nil {
 			return err
-		} else {
-			vmx.Set(fmt.Sprintf("ethernet%d.virtualDev", inetIndex), "vmxnet3")
+		} else if err = setEthernetVirtualDev(vmx, inetIndex, "vmxnet3"); err != nil {
+			return err
 		}
 
 		return vmx.Save(found.Path)
 	}
 }
 
+// RemoveNetworkInterface remove the interface nic, numbered from 1 like vmrest does. The VM must be powered off
+func (v *VmrunExe) RemoveNetworkInterface(vmuuid string, nic int) error {
+	defer v.locks.lock(vmuuid)()
+
+	if nic < 1 || nic > utils.MaxEthernetCards {
+		return status.Errorf(codes.InvalidArgument, "invalid network interface: %d, expected between 1 and %d", nic, utils.MaxEthernetCards)
+	} else if found, err := v.VirtualMachineByUUID(vmuuid); err != nil {
+		return status.Errorf(codes.NotFound, failedtofindvm, vmuuid, err)
+	} else if found.PowerState != PoweredOff {
+		return status.Errorf(codes.FailedPrecondition, "failed to remove network interface: %d of VM: %s, reason: not powered off", nic, vmuuid)
+	} else if err = v.client.DeleteNICDevice(vmuuid, nic); err != nil {
+		return status.Errorf(codes.NotFound, "failed to remove network interface: %d of VM: %s, reason: %v", nic, vmuuid, err)
+	}
+
+	return nil
+}
+
 func (v *VmrunExe) ListNetworks() ([]*NetworkDevice, error) {
 	if networks, err := v.client.GetAllNetworks(); err != nil {
 		return nil, err
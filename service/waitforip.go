@@ -0,0 +1,129 @@
+package service
+
+import (
+	"net"
+	"net/netip"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/status"
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/utils"
+	vagrant_utility "github.com/hashicorp/vagrant-vmware-desktop/go_src/vagrant-vmware-utility/utility"
+	codes "google.golang.org/grpc/codes"
+)
+
+// GuestInterface is the addresses reported by the guest for a network interface, index is the vmrest NIC index
+type GuestInterface struct {
+	Index      int      `json:"index,omitempty"`
+	MacAddress string   `json:"macaddress,omitempty"`
+	Addresses  []string `json:"addresses,omitempty"`
+}
+
+// GuestAddresses is the result of WaitForIP, address is the one of the awaited interface
+type GuestAddresses struct {
+	Address    string            `json:"address,omitempty"`
+	Interfaces []*GuestInterface `json:"interfaces,omitempty"`
+}
+
+// nicSelector match the network interface awaited by WaitForIP by its index, its MAC address or a subnet
+type nicSelector struct {
+	index  int
+	mac    string
+	subnet *net.IPNet
+}
+
+// parseNicSelector return nil for an empty network, WaitForIP then wait for the address reported by vmrun
+func parseNicSelector(network string) (*nicSelector, error) {
+	if network == "" {
+		return nil, nil
+	} else if index, err := strconv.Atoi(network); err == nil {
+		if index < 1 || index > utils.MaxEthernetCards {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid network interface: %d, expected between 1 and %d", index, utils.MaxEthernetCards)
+		}
+
+		return &nicSelector{index: index}, nil
+	} else if mac, err := net.ParseMAC(network); err == nil {
+		return &nicSelector{mac: mac.String()}, nil
+	} else if _, subnet, err := net.ParseCIDR(network); err == nil {
+		return &nicSelector{subnet: subnet}, nil
+	}
+
+	return nil, status.Errorf(codes.InvalidArgument, "invalid network: %s, expected a NIC index, a MAC address or a CIDR", network)
+}
+
+// address return the address of the interface if it's the awaited one, IPv4 is preferred unless a subnet is given
+func (s *nicSelector) address(inf *GuestInterface) string {
+	if s.subnet != nil {
+		for _, address := range inf.Addresses {
+			if ip := net.ParseIP(strings.Split(address, "/")[0]); ip != nil && s.subnet.Contains(ip) {
+				return ip.String()
+			}
+		}
+	} else if (s.index != 0 && inf.Index == s.index) || (s.mac != "" && strings.EqualFold(inf.MacAddress, s.mac)) {
+		for _, address := range inf.Addresses {
+			if ip, err := netip.ParseAddr(strings.Split(address, "/")[0]); err == nil && ip.Is4() {
+				return ip.String()
+			}
+		}
+	}
+
+	return ""
+}
+
+// guestInterfaces return the addresses of every interface as reported by vmrest nicips,
+// the index is resolved from the MAC address of the NIC devices. It's left to zero when
+// the NIC devices can't be listed, unless needIndex is set.
+func (v *VmrunExe) guestInterfaces(vm *VirtualMachine, needIndex bool) ([]*GuestInterface, error) {
+	indexes := make(map[string]int)
+
+	if nics, err := v.client.GetAllNICDevices(vm.Uuid); err == nil {
+		for _, nic := range nics.Nics {
+			indexes[strings.ToLower(nic.MacAddress)] = nic.Index
+		}
+	} else if needIndex {
+		return nil, status.Errorf(codes.Internal, "failed to list network interfaces of VM: %s, reason: %v", vm.Uuid, err)
+	}
+
+	if stack, err := v.getNicInfoPowered(vm); err != nil {
+		return nil, err
+	} else {
+		interfaces := make([]*GuestInterface, 0, len(stack))
+
+		for _, nic := range stack {
+			mac := strings.ToLower(nic.mac)
+
+			interfaces = append(interfaces, &GuestInterface{
+				Index:      indexes[mac],
+				MacAddress: mac,
+				Addresses:  nic.ip,
+			})
+		}
+
+		return interfaces, nil
+	}
+}
+
+// guestIPAddress return the address reported by vmrest or vmrun getGuestIPAddress, done is false while the guest has no address
+func (v *VmrunExe) guestIPAddress(vm *VirtualMachine) (address string, done bool, err error) {
+	if ipaddress, _ := v.client.GetIPAddress(vm.Uuid); ipaddress != nil && len(ipaddress.Ip) > 0 {
+		return ipaddress.Ip, true, nil
+	}
+
+	cmd := exec.Command(v.exePath, "getGuestIPAddress", vm.Path)
+	exitCode, out := vagrant_utility.ExecuteWithOutput(cmd)
+
+	if exitCode != 0 {
+		// Got it on linux
+		if strings.HasPrefix(out, "Error: Unable to get the IP address") || strings.HasPrefix(out, "Error: Cannot open VM:") || strings.HasPrefix(out, "Error: The VMware Tools are not running in the virtual machine") {
+			return "", false, nil
+		}
+
+		v.logger.Debug("vmrun getGuestIPAddress failed", "exitcode", exitCode)
+		v.logger.Trace("vmrun getGuestIPAddress failed", "output", out)
+
+		return "", false, status.Errorf(codes.Internal, "failed to get ip VM: %s, reason: %s", vm.Uuid, out)
+	}
+
+	return strings.Trim(out, "\n"), true, nil
+}
@@ -4,21 +4,25 @@ import "time"
 
 type CommonConfig struct {
 	Address         string
+	ConfigFolder    string
 	Driver          string
 	LicenseOverride string
 	Listen          string
 	LogDisplay      bool
+	Pools           map[string]int
 	Port            int64
 	Timeout         time.Duration
 	VMFolder        string
 	VMRestURL       string
 
-	Paddress         *string        `hcl:"address"`
-	Pdriver          *string        `hcl:"driver"`
-	PlicenseOverride *string        `hcl:"license_override"`
-	Plisten          *string        `hcl:"listen"`
-	Pport            *int64         `hcl:"port"`
-	Ptimeout         *time.Duration `hcl:"timeout"`
-	Pvmfolder        *string        `hcl:"vmfolder"`
-	Pvmrest          *string        `hcl:"vmrest"`
+	Paddress         *string         `hcl:"address"`
+	Pconfigfolder    *string         `hcl:"configfolder"`
+	Pdriver          *string         `hcl:"driver"`
+	PlicenseOverride *string         `hcl:"license_override"`
+	Plisten          *string         `hcl:"listen"`
+	Ppools           *map[string]int `hcl:"pools"`
+	Pport            *int64          `hcl:"port"`
+	Ptimeout         *time.Duration  `hcl:"timeout"`
+	Pvmfolder        *string         `hcl:"vmfolder"`
+	Pvmrest          *string         `hcl:"vmrest"`
 }
@@ -0,0 +1,111 @@
+package service_test
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/Fred78290/vmrest-go-client/client/model"
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/service"
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/settings"
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/test/fakevmrest"
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/test/fakevmrun"
+	"github.com/hashicorp/go-hclog"
+)
+
+func TestMain(m *testing.M) {
+	fakevmrun.Main()
+
+	os.Exit(m.Run())
+}
+
+// fakeBackend emulate vmrun and vmrest on top of VMX files in a temporary directory
+type fakeBackend struct {
+	dir    string
+	config string
+	vms    []model.Vmid
+	fake   *fakevmrun.Fake
+	srv    *fakevmrest.Server
+}
+
+func newFakeBackend(tb testing.TB, count int, runningEvery int) *fakeBackend {
+	dir := tb.TempDir()
+	fake, err := fakevmrun.New(dir)
+
+	if err != nil {
+		tb.Fatalf("failed to install fake vmrun: %v", err)
+	}
+
+	srv := fakevmrest.New()
+
+	// vmrest and vmrun share the power state
+	srv.Running = fake.Running
+
+	tb.Cleanup(srv.Close)
+
+	backend := &fakeBackend{
+		dir:    dir,
+		config: tb.TempDir(),
+		vms:    make([]model.Vmid, 0, count),
+		fake:   fake,
+		srv:    srv,
+	}
+
+	for i := 0; i < count; i++ {
+		vmx := backend.addVM(tb, fmt.Sprintf("vm-%d", i))
+
+		if runningEvery > 0 && i%runningEvery == 0 {
+			if err := fake.SetRunning(vmx, true); err != nil {
+				tb.Fatal(err)
+			}
+		}
+	}
+
+	return backend
+}
+
+func (f *fakeBackend) addVM(tb testing.TB, name string) string {
+	vmx := path.Join(f.dir, name+".vmx")
+
+	if err := os.WriteFile(vmx, []byte(fmt.Sprintf("displayName = \"%s\"\nnumvcpus = \"2\"\nmemsize = \"2048\"\n", name)), 0644); err != nil {
+		tb.Fatal(err)
+	}
+
+	f.vms = append(f.vms, model.Vmid{Id: f.srv.AddVM(vmx), Path: vmx})
+
+	return vmx
+}
+
+func (f *fakeBackend) running(vmuuid string) bool {
+	for _, vm := range f.srv.VMs() {
+		if vm.Id == vmuuid {
+			return f.fake.Running(vm.Path)
+		}
+	}
+
+	return false
+}
+
+func (f *fakeBackend) vmrun(tb testing.TB, options ...service.Option) service.Vmrun {
+	c := &settings.CommonConfig{
+		ConfigFolder: f.config,
+		Timeout:      30 * time.Second,
+		VMFolder:     f.dir,
+	}
+
+	options = append([]service.Option{service.WithRootOwned(fakevmrun.RootOwned)}, options...)
+
+	if client, err := f.srv.Client(); err != nil {
+		tb.Fatalf("vmrest api client failed: %v", err)
+	} else if vmrun, err := service.NewVmrun(c, f.fake.Vmrun(), f.fake.VdiskManager(), hclog.NewNullLogger(), options...); err != nil {
+		tb.Fatalf("fake vmrun rejected: %v", err)
+	} else {
+		vmrun.SetApiClient(client)
+
+		return vmrun
+	}
+
+	return nil
+}
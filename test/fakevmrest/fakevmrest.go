@@ -0,0 +1,231 @@
+// Package fakevmrest provide an in-process stand-in for the VMware vmrest API.
+//
+// The VMs are read from their VMX files like vmrest does, the networks, DHCP reservations
+// and port forwards live in memory. Any endpoint can be scripted to fail with an ErrorModel.
+package fakevmrest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/Fred78290/vmrest-go-client/client"
+	"github.com/Fred78290/vmrest-go-client/client/model"
+)
+
+const (
+	contentType = "application/vnd.vmware.vmw.rest-v1+json"
+
+	// ErrorNotPowered is the vmrest code returned when the VM isn't powered on or has no IP yet
+	ErrorNotPowered = 106
+	// ErrorNotFound is the vmrest code returned for an unknown resource
+	ErrorNotFound = 104
+	// ErrorInvalidParameter is the vmrest code returned for an invalid request
+	ErrorInvalidParameter = 101
+)
+
+// Failure is a scripted error, Times at zero fail forever and Status default to 500
+type Failure struct {
+	Times   int
+	Status  int
+	Code    int
+	Message string
+}
+
+type injected struct {
+	method  string
+	pattern string
+	failure Failure
+}
+
+// apiError carry the ErrorModel returned to the client
+type apiError struct {
+	status  int
+	code    int
+	message string
+}
+
+func (e *apiError) Error() string {
+	return e.message
+}
+
+func newError(status, code int, format string, args ...interface{}) *apiError {
+	return &apiError{
+		status:  status,
+		code:    code,
+		message: fmt.Sprintf(format, args...),
+	}
+}
+
+// errorBody mimic vmrest, the keys are capitalized and the driver rely on it
+type errorBody struct {
+	Code    int    `json:"Code"`
+	Message string `json:"Message"`
+}
+
+type handler func(req *http.Request, params []string) (interface{}, *apiError)
+
+type route struct {
+	method  string
+	path    *regexp.Regexp
+	handler handler
+}
+
+// Server is the fake vmrest, all the requests are served under its lock
+type Server struct {
+	sync.Mutex
+	// Running override the power state of the VMs, use it to share the state with the fake vmrun
+	Running      func(vmx string) bool
+	srv          *httptest.Server
+	routes       []*route
+	vms          []*VM
+	networks     []model.Network
+	mactoips     map[string][]model.MactoIp
+	portforwards map[string][]model.Portforward
+	failures     []*injected
+	nextID       int
+	nextMac      int
+}
+
+// New start a fake vmrest with the default vmnet1 host-only and vmnet8 NAT networks
+func New() *Server {
+	s := &Server{
+		networks: []model.Network{
+			{Name: "vmnet1", Type: "hostOnly", Dhcp: "true", Subnet: "192.168.1.0", Mask: "255.255.255.0"},
+			{Name: "vmnet8", Type: "nat", Dhcp: "true", Subnet: "192.168.2.0", Mask: "255.255.255.0"},
+		},
+		mactoips:     make(map[string][]model.MactoIp),
+		portforwards: make(map[string][]model.Portforward),
+	}
+
+	s.handle("GET", `/`, s.root)
+	s.handle("GET", `/api/vms`, s.getAllVMs)
+	s.handle("POST", `/api/vms`, s.createVM)
+	s.handle("POST", `/api/vms/registration`, s.registerVM)
+	s.handle("GET", `/api/vms/([^/]+)`, s.getVM)
+	s.handle("PUT", `/api/vms/([^/]+)`, s.updateVM)
+	s.handle("DELETE", `/api/vms/([^/]+)`, s.deleteVM)
+	s.handle("GET", `/api/vms/([^/]+)/params/(.+)`, s.getVMParams)
+	s.handle("PUT", `/api/vms/([^/]+)/configparams`, s.configVMParams)
+	s.handle("GET", `/api/vms/([^/]+)/power`, s.getPowerState)
+	s.handle("PUT", `/api/vms/([^/]+)/power`, s.changePowerState)
+	s.handle("GET", `/api/vms/([^/]+)/ip`, s.getIPAddress)
+	s.handle("GET", `/api/vms/([^/]+)/nic`, s.getAllNICDevices)
+	s.handle("POST", `/api/vms/([^/]+)/nic`, s.createNICDevice)
+	s.handle("PUT", `/api/vms/([^/]+)/nic/(\d+)`, s.updateNICDevice)
+	s.handle("DELETE", `/api/vms/([^/]+)/nic/(\d+)`, s.deleteNICDevice)
+	s.handle("GET", `/api/vms/([^/]+)/nicips`, s.getNicInfo)
+	s.handle("GET", `/api/vmnet`, s.getAllNetworks)
+	s.handle("POST", `/api/vmnets`, s.createNetwork)
+	s.handle("GET", `/api/vmnet/([^/]+)/mactoip`, s.getMACToIPs)
+	s.handle("PUT", `/api/vmnet/([^/]+)/mactoip/([^/]+)`, s.updateMacToIP)
+	s.handle("GET", `/api/vmnet/([^/]+)/portforward`, s.getPortforwards)
+	s.handle("PUT", `/api/vmnet/([^/]+)/portforward/(tcp|udp)/(\d+)`, s.updatePortforward)
+	s.handle("DELETE", `/api/vmnet/([^/]+)/portforward/(tcp|udp)/(\d+)`, s.deletePortforward)
+
+	s.srv = httptest.NewServer(s)
+
+	return s
+}
+
+func (s *Server) handle(method, pattern string, h handler) {
+	s.routes = append(s.routes, &route{
+		method:  method,
+		path:    regexp.MustCompile("^" + pattern + "$"),
+		handler: h,
+	})
+}
+
+func (s *Server) URL() string {
+	return s.srv.URL
+}
+
+func (s *Server) Close() {
+	s.srv.Close()
+}
+
+// Client return a vmrest client connected to the fake
+func (s *Server) Client() (*client.APIClient, error) {
+	return client.NewAPIClient(&client.Configuration{
+		Endpoint:  s.srv.URL,
+		UserAgent: "fakevmrest",
+		Timeout:   30,
+	})
+}
+
+// Fail script the requests matching the method and the path pattern to fail, see path.Match for the pattern
+func (s *Server) Fail(method, pattern string, failure Failure) {
+	s.Lock()
+	defer s.Unlock()
+
+	if failure.Status == 0 {
+		failure.Status = http.StatusInternalServerError
+	}
+
+	s.failures = append(s.failures, &injected{
+		method:  strings.ToUpper(method),
+		pattern: pattern,
+		failure: failure,
+	})
+}
+
+func (s *Server) injectedFailure(method, urlPath string) *apiError {
+	for i, inject := range s.failures {
+		if matched, _ := path.Match(inject.pattern, urlPath); matched && inject.method == method {
+			if inject.failure.Times--; inject.failure.Times == 0 {
+				s.failures = append(s.failures[:i], s.failures[i+1:]...)
+			}
+
+			return newError(inject.failure.Status, inject.failure.Code, "%s", inject.failure.Message)
+		}
+	}
+
+	return nil
+}
+
+func (s *Server) ServeHTTP(wr http.ResponseWriter, req *http.Request) {
+	var result interface{}
+
+	s.Lock()
+	defer s.Unlock()
+
+	err := s.injectedFailure(req.Method, req.URL.Path)
+
+	if err == nil {
+		err = newError(http.StatusNotFound, ErrorNotFound, "unsupported request: %s %s", req.Method, req.URL.Path)
+
+		for _, r := range s.routes {
+			if params := r.path.FindStringSubmatch(req.URL.Path); params != nil && r.method == req.Method {
+				result, err = r.handler(req, params[1:])
+				break
+			}
+		}
+	}
+
+	wr.Header().Set("Content-Type", contentType)
+
+	if err != nil {
+		wr.WriteHeader(err.status)
+		json.NewEncoder(wr).Encode(&errorBody{Code: err.code, Message: err.message})
+	} else {
+		wr.WriteHeader(http.StatusOK)
+		json.NewEncoder(wr).Encode(result)
+	}
+}
+
+func (s *Server) root(req *http.Request, params []string) (interface{}, *apiError) {
+	return map[string]string{}, nil
+}
+
+func readBody(req *http.Request, body interface{}) *apiError {
+	if err := json.NewDecoder(req.Body).Decode(body); err != nil {
+		return newError(http.StatusBadRequest, ErrorInvalidParameter, "invalid body: %v", err)
+	}
+
+	return nil
+}
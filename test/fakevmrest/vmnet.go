@@ -0,0 +1,196 @@
+package fakevmrest
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/Fred78290/vmrest-go-client/client/model"
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/utils"
+)
+
+// AddNetwork register a virtual network, an existing network with the same name is replaced
+func (s *Server) AddNetwork(network model.Network) {
+	s.Lock()
+	defer s.Unlock()
+
+	if _, index := s.findNetwork(network.Name); index >= 0 {
+		s.networks[index] = network
+	} else {
+		s.networks = append(s.networks, network)
+	}
+}
+
+// MacToIPs return the DHCP reservations of a vmnet
+func (s *Server) MacToIPs(vmnet string) []model.MactoIp {
+	s.Lock()
+	defer s.Unlock()
+
+	return append([]model.MactoIp{}, s.mactoips[vmnet]...)
+}
+
+// Portforwards return the port forwards of a vmnet
+func (s *Server) Portforwards(vmnet string) []model.Portforward {
+	s.Lock()
+	defer s.Unlock()
+
+	return append([]model.Portforward{}, s.portforwards[vmnet]...)
+}
+
+func (s *Server) findNetwork(name string) (*model.Network, int) {
+	for i := range s.networks {
+		if s.networks[i].Name == name {
+			return &s.networks[i], i
+		}
+	}
+
+	return nil, -1
+}
+
+func networkNotFound(name string) *apiError {
+	return newError(http.StatusNotFound, ErrorNotFound, "The virtual network: %s, is not found", name)
+}
+
+func (s *Server) getAllNetworks(req *http.Request, params []string) (interface{}, *apiError) {
+	return &model.Networks{
+		Num:    len(s.networks),
+		Vmnets: append([]model.Network{}, s.networks...),
+	}, nil
+}
+
+func (s *Server) createNetwork(req *http.Request, params []string) (interface{}, *apiError) {
+	var create model.CreateVmnetParameter
+
+	if err := readBody(req, &create); err != nil {
+		return nil, err
+	} else if create.Name == "" {
+		return nil, newError(http.StatusBadRequest, ErrorInvalidParameter, "vmnet name is required")
+	} else if network, _ := s.findNetwork(create.Name); network != nil {
+		return nil, newError(http.StatusBadRequest, ErrorInvalidParameter, "The virtual network: %s, already exists", create.Name)
+	} else {
+		network := model.Network{
+			Name: create.Name,
+			Type: create.Type,
+			Dhcp: "false",
+		}
+
+		if network.Type == "" {
+			network.Type = "hostOnly"
+		}
+
+		s.networks = append(s.networks, network)
+
+		return &network, nil
+	}
+}
+
+func (s *Server) getMACToIPs(req *http.Request, params []string) (interface{}, *apiError) {
+	if network, _ := s.findNetwork(params[0]); network == nil {
+		return nil, networkNotFound(params[0])
+	} else {
+		mactoips := s.mactoips[network.Name]
+
+		return &model.MactoIps{Num: len(mactoips), Mactoips: append([]model.MactoIp{}, mactoips...)}, nil
+	}
+}
+
+// updateMacToIP add, replace or remove when the IP is empty a DHCP reservation
+func (s *Server) updateMacToIP(req *http.Request, params []string) (interface{}, *apiError) {
+	var param model.MacToIpParameter
+
+	if err := readBody(req, &param); err != nil {
+		return nil, err
+	} else if network, _ := s.findNetwork(params[0]); network == nil {
+		return nil, networkNotFound(params[0])
+	} else {
+		mac := strings.ToLower(params[1])
+		mactoips := make([]model.MactoIp, 0, len(s.mactoips[network.Name])+1)
+
+		for _, mactoip := range s.mactoips[network.Name] {
+			if mactoip.Mac != mac {
+				mactoips = append(mactoips, mactoip)
+			}
+		}
+
+		if param.IP != "" {
+			for _, mactoip := range mactoips {
+				if mactoip.Ip == param.IP {
+					return nil, newError(http.StatusBadRequest, ErrorInvalidParameter, "The IP address: %s, is already reserved by: %s", param.IP, mactoip.Mac)
+				}
+			}
+
+			mactoips = append(mactoips, model.MactoIp{Vmnet: network.Name, Mac: mac, Ip: param.IP})
+		}
+
+		s.mactoips[network.Name] = mactoips
+
+		return &model.ErrorModel{}, nil
+	}
+}
+
+func (s *Server) getPortforwards(req *http.Request, params []string) (interface{}, *apiError) {
+	if network, _ := s.findNetwork(params[0]); network == nil {
+		return nil, networkNotFound(params[0])
+	} else {
+		portforwards := s.portforwards[network.Name]
+
+		return &model.Portforwards{Num: len(portforwards), PortForwardings: append([]model.Portforward{}, portforwards...)}, nil
+	}
+}
+
+// findPortforward return the index of the forward of the host port
+func (s *Server) findPortforward(vmnet, protocol string, port int) int {
+	for i, portforward := range s.portforwards[vmnet] {
+		if portforward.Protocol == protocol && portforward.Port == port {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func (s *Server) updatePortforward(req *http.Request, params []string) (interface{}, *apiError) {
+	var param model.PortforwardParameter
+
+	if err := readBody(req, &param); err != nil {
+		return nil, err
+	} else if network, _ := s.findNetwork(params[0]); network == nil {
+		return nil, networkNotFound(params[0])
+	} else if network.Type != "nat" {
+		return nil, newError(http.StatusBadRequest, ErrorInvalidParameter, "The virtual network: %s, is not a NAT network", network.Name)
+	} else if param.GuestIp == "" || param.GuestPort == 0 {
+		return nil, newError(http.StatusBadRequest, ErrorInvalidParameter, "guest IP and port are required")
+	} else {
+		port := utils.StrToInt(params[2])
+		portforward := model.Portforward{
+			Port:     port,
+			Protocol: params[1],
+			Desc:     param.Desc,
+			Guest: &model.PortforwardGuest{
+				Ip:   param.GuestIp,
+				Port: param.GuestPort,
+			},
+		}
+
+		if index := s.findPortforward(network.Name, params[1], port); index >= 0 {
+			s.portforwards[network.Name][index] = portforward
+		} else {
+			s.portforwards[network.Name] = append(s.portforwards[network.Name], portforward)
+		}
+
+		return &model.ErrorModel{}, nil
+	}
+}
+
+func (s *Server) deletePortforward(req *http.Request, params []string) (interface{}, *apiError) {
+	if network, _ := s.findNetwork(params[0]); network == nil {
+		return nil, networkNotFound(params[0])
+	} else if index := s.findPortforward(network.Name, params[1], utils.StrToInt(params[2])); index < 0 {
+		return nil, newError(http.StatusNotFound, ErrorNotFound, "The port forward: %s/%s, is not found", params[1], params[2])
+	} else {
+		portforwards := s.portforwards[network.Name]
+
+		s.portforwards[network.Name] = append(portforwards[:index], portforwards[index+1:]...)
+
+		return nil, nil
+	}
+}
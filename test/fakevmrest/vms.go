@@ -0,0 +1,525 @@
+package fakevmrest
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/Fred78290/vmrest-go-client/client/model"
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/utils"
+)
+
+const (
+	poweredOn  = "poweredOn"
+	poweredOff = "poweredOff"
+	suspended  = "suspended"
+	paused     = "paused"
+	maxNics    = 10
+)
+
+// VM is a virtual machine registered in the fake, its settings are read from the VMX
+type VM struct {
+	Id   string
+	Path string
+	// PowerState is ignored when the server define Running
+	PowerState string
+	// IP is reported by /ip and /nicips once powered on
+	IP string
+	// NicIPs is reported by /nicips for the NIC index once powered on
+	NicIPs map[int]string
+}
+
+// AddVM register a VMX and return its id
+func (s *Server) AddVM(vmx string) string {
+	s.Lock()
+	defer s.Unlock()
+
+	return s.addVM(vmx).Id
+}
+
+// SetIP change the address reported by the guest
+func (s *Server) SetIP(id, ip string) {
+	s.Lock()
+	defer s.Unlock()
+
+	if vm, found := s.findVM(id); found {
+		vm.IP = ip
+	}
+}
+
+// SetNicIP change the address reported by the guest for a NIC, vmrest index them from 1
+func (s *Server) SetNicIP(id string, nic int, ip string) {
+	s.Lock()
+	defer s.Unlock()
+
+	if vm, found := s.findVM(id); found {
+		if vm.NicIPs == nil {
+			vm.NicIPs = make(map[int]string)
+		}
+
+		vm.NicIPs[nic] = ip
+	}
+}
+
+// SetPowerState change the power state of a VM when the server doesn't define Running
+func (s *Server) SetPowerState(id, state string) {
+	s.Lock()
+	defer s.Unlock()
+
+	if vm, found := s.findVM(id); found {
+		vm.PowerState = state
+	}
+}
+
+// VMs return a copy of the registered VMs
+func (s *Server) VMs() []VM {
+	s.Lock()
+	defer s.Unlock()
+
+	vms := make([]VM, 0, len(s.vms))
+
+	for _, vm := range s.vms {
+		vms = append(vms, *vm)
+	}
+
+	return vms
+}
+
+func (s *Server) addVM(vmx string) *VM {
+	s.nextID++
+
+	vm := &VM{
+		Id:         fmt.Sprintf("%032X", s.nextID),
+		Path:       vmx,
+		PowerState: poweredOff,
+	}
+
+	s.vms = append(s.vms, vm)
+
+	return vm
+}
+
+func (s *Server) findVM(id string) (*VM, bool) {
+	for _, vm := range s.vms {
+		if vm.Id == id {
+			return vm, true
+		}
+	}
+
+	return nil, false
+}
+
+func (s *Server) powerState(vm *VM) string {
+	if s.Running == nil {
+		return vm.PowerState
+	} else if s.Running(vm.Path) {
+		return poweredOn
+	}
+
+	return poweredOff
+}
+
+func vmNotFound(id string) *apiError {
+	return newError(http.StatusNotFound, ErrorNotFound, "The virtual machine: %s, is not found", id)
+}
+
+func notPowered(id string) *apiError {
+	return newError(http.StatusInternalServerError, ErrorNotPowered, "The virtual machine: %s, is not powered on", id)
+}
+
+// loadVM return the VM and its VMX
+func (s *Server) loadVM(id string) (*VM, *utils.VMXMap, *apiError) {
+	if vm, found := s.findVM(id); !found {
+		return nil, nil, vmNotFound(id)
+	} else if vmx, err := utils.LoadVMX(vm.Path); err != nil {
+		return nil, nil, newError(http.StatusInternalServerError, ErrorNotFound, "unable to load VMX: %s, reason: %v", vm.Path, err)
+	} else {
+		return vm, vmx, nil
+	}
+}
+
+func saveVMX(vm *VM, vmx *utils.VMXMap) *apiError {
+	if err := vmx.Save(vm.Path); err != nil {
+		return newError(http.StatusInternalServerError, ErrorInvalidParameter, "unable to save VMX: %s, reason: %v", vm.Path, err)
+	}
+
+	return nil
+}
+
+func vmInformation(vm *VM, vmx *utils.VMXMap) *model.VmInformation {
+	vcpus := utils.StrToInt(vmx.Get("numvcpus"))
+
+	if vcpus == 0 {
+		vcpus = 1
+	}
+
+	return &model.VmInformation{
+		Id:     vm.Id,
+		Cpu:    &model.Vmcpu{Processors: vcpus},
+		Memory: utils.StrToInt(vmx.Get("memsize")),
+	}
+}
+
+func (s *Server) getAllVMs(req *http.Request, params []string) (interface{}, *apiError) {
+	vms := make([]model.Vmid, 0, len(s.vms))
+
+	for _, vm := range s.vms {
+		vms = append(vms, model.Vmid{Id: vm.Id, Path: vm.Path})
+	}
+
+	return vms, nil
+}
+
+// createVM clone the VMX of the parent next to it
+func (s *Server) createVM(req *http.Request, params []string) (interface{}, *apiError) {
+	var clone model.VmCloneParameter
+
+	if err := readBody(req, &clone); err != nil {
+		return nil, err
+	} else if clone.Name == "" {
+		return nil, newError(http.StatusBadRequest, ErrorInvalidParameter, "VM name is required")
+	} else if parent, found := s.findVM(clone.ParentId); !found {
+		return nil, vmNotFound(clone.ParentId)
+	} else {
+		target := filepath.Join(filepath.Dir(parent.Path), clone.Name+".vmx")
+
+		if utils.FileExists(target) {
+			return nil, newError(http.StatusBadRequest, ErrorInvalidParameter, "The file already exists: %s", target)
+		} else if content, e := os.ReadFile(parent.Path); e != nil {
+			return nil, newError(http.StatusInternalServerError, ErrorNotFound, "unable to read VMX: %s, reason: %v", parent.Path, e)
+		} else if e = os.WriteFile(target, content, 0644); e != nil {
+			return nil, newError(http.StatusInternalServerError, ErrorInvalidParameter, "unable to write VMX: %s, reason: %v", target, e)
+		}
+
+		vm := s.addVM(target)
+
+		if _, vmx, err := s.loadVM(vm.Id); err != nil {
+			return nil, err
+		} else {
+			vmx.Set("displayName", clone.Name)
+
+			if err = saveVMX(vm, vmx); err != nil {
+				return nil, err
+			}
+
+			return vmInformation(vm, vmx), nil
+		}
+	}
+}
+
+func (s *Server) registerVM(req *http.Request, params []string) (interface{}, *apiError) {
+	var register model.VmRegisterParameter
+
+	if err := readBody(req, &register); err != nil {
+		return nil, err
+	} else if !utils.FileExists(register.Path) {
+		return nil, newError(http.StatusBadRequest, ErrorInvalidParameter, "The file is not found: %s", register.Path)
+	}
+
+	for _, vm := range s.vms {
+		if vm.Path == register.Path {
+			return &model.VmRegistrationInformation{Id: vm.Id, Path: vm.Path}, nil
+		}
+	}
+
+	vm := s.addVM(register.Path)
+
+	return &model.VmRegistrationInformation{Id: vm.Id, Path: vm.Path}, nil
+}
+
+func (s *Server) getVM(req *http.Request, params []string) (interface{}, *apiError) {
+	if vm, vmx, err := s.loadVM(params[0]); err != nil {
+		return nil, err
+	} else {
+		return vmInformation(vm, vmx), nil
+	}
+}
+
+func (s *Server) updateVM(req *http.Request, params []string) (interface{}, *apiError) {
+	var update model.VmParameter
+
+	if err := readBody(req, &update); err != nil {
+		return nil, err
+	} else if vm, vmx, err := s.loadVM(params[0]); err != nil {
+		return nil, err
+	} else {
+		if update.Processors > 0 {
+			vmx.Set("numvcpus", strconv.Itoa(update.Processors))
+		}
+
+		if update.Memory > 0 {
+			vmx.Set("memsize", strconv.Itoa(update.Memory))
+		}
+
+		if err = saveVMX(vm, vmx); err != nil {
+			return nil, err
+		}
+
+		return vmInformation(vm, vmx), nil
+	}
+}
+
+// deleteVM remove the files of the VM sharing the VMX name
+func (s *Server) deleteVM(req *http.Request, params []string) (interface{}, *apiError) {
+	for i, vm := range s.vms {
+		if vm.Id == params[0] {
+			if s.powerState(vm) != poweredOff {
+				return nil, newError(http.StatusBadRequest, ErrorInvalidParameter, "The virtual machine: %s, is in use", vm.Id)
+			}
+
+			stem := strings.TrimSuffix(vm.Path, filepath.Ext(vm.Path))
+
+			for _, pattern := range []string{stem + ".*", stem + "-*"} {
+				files, _ := filepath.Glob(pattern)
+
+				for _, file := range files {
+					os.RemoveAll(file)
+				}
+			}
+
+			s.vms = append(s.vms[:i], s.vms[i+1:]...)
+
+			return nil, nil
+		}
+	}
+
+	return nil, vmNotFound(params[0])
+}
+
+func (s *Server) getVMParams(req *http.Request, params []string) (interface{}, *apiError) {
+	if _, vmx, err := s.loadVM(params[0]); err != nil {
+		return nil, err
+	} else {
+		return &model.ConfigVmParamsParameter{Name: params[1], Value: vmx.Get(params[1])}, nil
+	}
+}
+
+func (s *Server) configVMParams(req *http.Request, params []string) (interface{}, *apiError) {
+	var param model.ConfigVmParamsParameter
+
+	if err := readBody(req, &param); err != nil {
+		return nil, err
+	} else if vm, vmx, err := s.loadVM(params[0]); err != nil {
+		return nil, err
+	} else {
+		vmx.Set(param.Name, param.Value)
+
+		if err = saveVMX(vm, vmx); err != nil {
+			return nil, err
+		}
+
+		return &model.ErrorModel{}, nil
+	}
+}
+
+func (s *Server) getPowerState(req *http.Request, params []string) (interface{}, *apiError) {
+	if vm, found := s.findVM(params[0]); !found {
+		return nil, vmNotFound(params[0])
+	} else {
+		return &model.VmPowerState{PowerState: s.powerState(vm)}, nil
+	}
+}
+
+func (s *Server) changePowerState(req *http.Request, params []string) (interface{}, *apiError) {
+	body, _ := io.ReadAll(req.Body)
+	operation := model.VmPowerOperation(strings.Trim(strings.TrimSpace(string(body)), `"`))
+	states := map[model.VmPowerOperation]string{
+		model.VM_ON:       poweredOn,
+		model.VM_OFF:      poweredOff,
+		model.VM_SHUTDOWN: poweredOff,
+		model.VM_SUSPEND:  suspended,
+		model.VM_PAUSE:    paused,
+		model.VM_UNPAUSE:  poweredOn,
+	}
+
+	if vm, found := s.findVM(params[0]); !found {
+		return nil, vmNotFound(params[0])
+	} else if state, found := states[operation]; !found {
+		return nil, newError(http.StatusBadRequest, ErrorInvalidParameter, "Invalid power operation: %s", operation)
+	} else {
+		vm.PowerState = state
+
+		return &model.VmPowerState{PowerState: s.powerState(vm)}, nil
+	}
+}
+
+func (s *Server) getIPAddress(req *http.Request, params []string) (interface{}, *apiError) {
+	if vm, found := s.findVM(params[0]); !found {
+		return nil, vmNotFound(params[0])
+	} else if s.powerState(vm) != poweredOn || vm.IP == "" {
+		return nil, notPowered(vm.Id)
+	} else {
+		return &model.InlineResponse200{Ip: vm.IP}, nil
+	}
+}
+
+// nicDevices read the network adapters from the VMX, vmrest index them from 1
+func nicDevices(vmx *utils.VMXMap) []model.NicDevice {
+	nics := make([]model.NicDevice, 0, maxNics)
+
+	for card := 0; card < maxNics; card++ {
+		ethernet := fmt.Sprintf("ethernet%d.", card)
+
+		if utils.StrToBool(vmx.Get(ethernet + "present")) {
+			mac := vmx.Get(ethernet + "address")
+
+			if vmx.Get(ethernet+"addresstype") == "generated" {
+				mac = vmx.Get(ethernet + "generatedaddress")
+			}
+
+			nics = append(nics, model.NicDevice{
+				Index:      card + 1,
+				Type:       vmx.Get(ethernet + "connectiontype"),
+				Vmnet:      vmx.Get(ethernet + "vnet"),
+				MacAddress: mac,
+			})
+		}
+	}
+
+	return nics
+}
+
+func (s *Server) getAllNICDevices(req *http.Request, params []string) (interface{}, *apiError) {
+	if _, vmx, err := s.loadVM(params[0]); err != nil {
+		return nil, err
+	} else {
+		nics := nicDevices(vmx)
+
+		return &model.NicDevices{Num: len(nics), Nics: nics}, nil
+	}
+}
+
+func setNicDevice(vmx *utils.VMXMap, card int, nic *model.NicDeviceParameter) *apiError {
+	ethernet := fmt.Sprintf("ethernet%d.", card)
+
+	if nic.Type == "custom" && nic.Vmnet == "" {
+		return newError(http.StatusBadRequest, ErrorInvalidParameter, "vmnet is required for custom network adapter")
+	}
+
+	vmx.Set(ethernet+"connectionType", nic.Type)
+
+	if nic.Type == "custom" {
+		vmx.Set(ethernet+"vnet", nic.Vmnet)
+	} else {
+		vmx.Delete(ethernet + "vnet")
+	}
+
+	return nil
+}
+
+func (s *Server) createNICDevice(req *http.Request, params []string) (interface{}, *apiError) {
+	var nic model.NicDeviceParameter
+
+	if err := readBody(req, &nic); err != nil {
+		return nil, err
+	} else if vm, vmx, err := s.loadVM(params[0]); err != nil {
+		return nil, err
+	} else {
+		for card := 0; card < maxNics; card++ {
+			ethernet := fmt.Sprintf("ethernet%d.", card)
+
+			if !utils.StrToBool(vmx.Get(ethernet + "present")) {
+				if err = setNicDevice(vmx, card, &nic); err != nil {
+					return nil, err
+				}
+
+				s.nextMac++
+
+				mac := fmt.Sprintf("00:0c:29:%02x:%02x:%02x", (s.nextMac>>16)&0xff, (s.nextMac>>8)&0xff, s.nextMac&0xff)
+
+				vmx.Set(ethernet+"present", "TRUE")
+				vmx.Set(ethernet+"addressType", "generated")
+				vmx.Set(ethernet+"generatedAddress", mac)
+
+				if err = saveVMX(vm, vmx); err != nil {
+					return nil, err
+				}
+
+				return &model.NicDevice{Index: card + 1, Type: nic.Type, Vmnet: nic.Vmnet, MacAddress: mac}, nil
+			}
+		}
+
+		return nil, newError(http.StatusBadRequest, ErrorInvalidParameter, "No free network adapter slot for VM: %s", vm.Id)
+	}
+}
+
+func (s *Server) nicCard(vmx *utils.VMXMap, index string) (int, *apiError) {
+	card := utils.StrToInt(index) - 1
+
+	if card < 0 || !utils.StrToBool(vmx.Get(fmt.Sprintf("ethernet%d.present", card))) {
+		return card, newError(http.StatusNotFound, ErrorNotFound, "The network adapter: %s, is not found", index)
+	}
+
+	return card, nil
+}
+
+func (s *Server) updateNICDevice(req *http.Request, params []string) (interface{}, *apiError) {
+	var nic model.NicDeviceParameter
+
+	if err := readBody(req, &nic); err != nil {
+		return nil, err
+	} else if vm, vmx, err := s.loadVM(params[0]); err != nil {
+		return nil, err
+	} else if card, err := s.nicCard(vmx, params[1]); err != nil {
+		return nil, err
+	} else if err = setNicDevice(vmx, card, &nic); err != nil {
+		return nil, err
+	} else if err = saveVMX(vm, vmx); err != nil {
+		return nil, err
+	} else {
+		for _, device := range nicDevices(vmx) {
+			if device.Index == card+1 {
+				return &device, nil
+			}
+		}
+
+		return nil, nil
+	}
+}
+
+func (s *Server) deleteNICDevice(req *http.Request, params []string) (interface{}, *apiError) {
+	if vm, vmx, err := s.loadVM(params[0]); err != nil {
+		return nil, err
+	} else if card, err := s.nicCard(vmx, params[1]); err != nil {
+		return nil, err
+	} else {
+		prefix := fmt.Sprintf("ethernet%d.", card)
+
+		for _, key := range vmx.Keys() {
+			if strings.HasPrefix(key, prefix) {
+				vmx.Delete(key)
+			}
+		}
+
+		return nil, saveVMX(vm, vmx)
+	}
+}
+
+// getNicInfo report the IP of the VM on its first network adapter
+func (s *Server) getNicInfo(req *http.Request, params []string) (interface{}, *apiError) {
+	if vm, vmx, err := s.loadVM(params[0]); err != nil {
+		return nil, err
+	} else if s.powerState(vm) != poweredOn {
+		return nil, notPowered(vm.Id)
+	} else {
+		result := &model.NicIpStackAll{}
+
+		for i, nic := range nicDevices(vmx) {
+			stack := model.NicIpStack{Mac: nic.MacAddress}
+
+			if ip, found := vm.NicIPs[nic.Index]; found {
+				stack.Ip = []string{ip + "/24"}
+			} else if i == 0 && vm.IP != "" {
+				stack.Ip = []string{vm.IP + "/24"}
+			}
+
+			result.Nics = append(result.Nics, stack)
+		}
+
+		return result, nil
+	}
+}
@@ -0,0 +1,302 @@
+// Package fakevmrun provide fake vmrun and vmware-vdiskmanager executables for hermetic tests.
+//
+// The executables are links to the test binary, TestMain must call Main first so the test binary
+// act as the fake when invoked through one of them. The state is kept on disk next to the links
+// and shared by every invocation.
+package fakevmrun
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	vagrant_utility "github.com/hashicorp/vagrant-vmware-desktop/go_src/vagrant-vmware-utility/utility"
+)
+
+const (
+	VmrunName        = "vmrun"
+	VdiskManagerName = "vmware-vdiskmanager"
+	stateFile        = "state.json"
+	lockDir          = "state.lock"
+	lockTimeout      = 10 * time.Second
+	errorExitCode    = 255
+)
+
+// Failure is a scripted failure, Times at zero fail forever
+type Failure struct {
+	Times  int    `json:"times"`
+	Output string `json:"output"`
+}
+
+type state struct {
+	Running  map[string]bool     `json:"running"`
+	Failures map[string]*Failure `json:"failures"`
+	Calls    []string            `json:"calls"`
+	Args     [][]string          `json:"args"`
+}
+
+// Fake is a directory holding the fake executables and their state
+type Fake struct {
+	dir string
+}
+
+func executableName(name string) string {
+	if runtime.GOOS == "windows" {
+		return name + ".exe"
+	}
+
+	return name
+}
+
+// Main run the fake and exit when the test binary is invoked as vmrun or vmware-vdiskmanager
+func Main() {
+	name := strings.TrimSuffix(filepath.Base(os.Args[0]), ".exe")
+
+	if name == VmrunName || name == VdiskManagerName {
+		f := &Fake{dir: filepath.Dir(os.Args[0])}
+
+		os.Exit(f.run(name, os.Args[1:], os.Stdout))
+	}
+}
+
+// RootOwned accept the fake executables and fallback to the vagrant check otherwise
+func RootOwned(path string, andAllParents bool) bool {
+	if name := strings.TrimSuffix(filepath.Base(path), ".exe"); name == VmrunName || name == VdiskManagerName {
+		if _, err := os.Stat(filepath.Join(filepath.Dir(path), stateFile)); err == nil {
+			return true
+		}
+	}
+
+	return vagrant_utility.RootOwned(path, andAllParents)
+}
+
+// New install the fake executables in dir
+func New(dir string) (*Fake, error) {
+	exe, err := os.Executable()
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, name := range []string{VmrunName, VdiskManagerName} {
+		target := filepath.Join(dir, executableName(name))
+
+		// Symlinks aren't always allowed on windows
+		if err = os.Symlink(exe, target); err != nil {
+			if err = copyFile(exe, target, 0755); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// A race enabled test binary sleep one second at exit, the fakes inherit the environment
+	if gorace := os.Getenv("GORACE"); !strings.Contains(gorace, "atexit_sleep_ms") {
+		os.Setenv("GORACE", strings.TrimSpace(gorace+" atexit_sleep_ms=0"))
+	}
+
+	f := &Fake{dir: dir}
+
+	return f, f.save(&state{})
+}
+
+func (f *Fake) Vmrun() string {
+	return filepath.Join(f.dir, executableName(VmrunName))
+}
+
+func (f *Fake) VdiskManager() string {
+	return filepath.Join(f.dir, executableName(VdiskManagerName))
+}
+
+// Fail script the next invocations of a command to fail with output.
+// vmrun commands are named as on the command line, vmware-vdiskmanager ones are create and expand
+func (f *Fake) Fail(command string, times int, output string) error {
+	return f.update(func(st *state) error {
+		st.Failures[command] = &Failure{Times: times, Output: output}
+
+		return nil
+	})
+}
+
+// SetRunning change the power state of a VM without calling vmrun
+func (f *Fake) SetRunning(vmx string, running bool) error {
+	return f.update(func(st *state) error {
+		if running {
+			st.Running[vmx] = true
+		} else {
+			delete(st.Running, vmx)
+		}
+
+		return nil
+	})
+}
+
+func (f *Fake) Running(vmx string) (running bool) {
+	f.update(func(st *state) error {
+		running = st.Running[vmx]
+
+		return nil
+	})
+
+	return
+}
+
+// Invocations return the arguments of every invocation of a command, the operation flag of vmware-vdiskmanager excluded
+func (f *Fake) Invocations(command string) (invocations [][]string) {
+	f.update(func(st *state) error {
+		for i, call := range st.Calls {
+			if call == command && i < len(st.Args) {
+				invocations = append(invocations, st.Args[i])
+			}
+		}
+
+		return nil
+	})
+
+	return
+}
+
+// Calls count the invocations of a command
+func (f *Fake) Calls(command string) (count int) {
+	f.update(func(st *state) error {
+		for _, call := range st.Calls {
+			if call == command {
+				count++
+			}
+		}
+
+		return nil
+	})
+
+	return
+}
+
+func (f *Fake) run(name string, args []string, out io.Writer) (exitCode int) {
+	var command string
+	var run func([]string) (string, error)
+
+	if name == VmrunName {
+		command, args = vmrunCommand(args)
+		run = func(args []string) (string, error) {
+			return f.vmrun(command, args)
+		}
+	} else {
+		command, args = vdiskManagerCommand(args)
+		run = func(args []string) (string, error) {
+			return vdiskManager(command, args)
+		}
+	}
+
+	err := f.update(func(st *state) error {
+		st.Calls = append(st.Calls, command)
+		st.Args = append(st.Args, args)
+
+		if failure, found := st.Failures[command]; found {
+			if failure.Times--; failure.Times == 0 {
+				delete(st.Failures, command)
+			}
+
+			return errors.New(failure.Output)
+		}
+
+		return nil
+	})
+
+	if err == nil {
+		var output string
+
+		if output, err = run(args); err == nil {
+			fmt.Fprint(out, output)
+
+			return 0
+		}
+	}
+
+	fmt.Fprintln(out, err.Error())
+
+	return errorExitCode
+}
+
+// update run fn with the state locked, the state is saved even when fn fail so scripted failures are consumed
+func (f *Fake) update(fn func(st *state) error) error {
+	if err := f.lock(); err != nil {
+		return err
+	}
+
+	defer f.unlock()
+
+	st, err := f.load()
+
+	if err != nil {
+		return err
+	}
+
+	if err = fn(st); err != nil {
+		f.save(st)
+
+		return err
+	}
+
+	return f.save(st)
+}
+
+func (f *Fake) lock() error {
+	deadline := time.Now().Add(lockTimeout)
+
+	for {
+		if err := os.Mkdir(filepath.Join(f.dir, lockDir), 0755); err == nil {
+			return nil
+		} else if !os.IsExist(err) {
+			return err
+		} else if time.Now().After(deadline) {
+			return fmt.Errorf("Error: timeout waiting fake state lock: %s", f.dir)
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func (f *Fake) unlock() {
+	os.Remove(filepath.Join(f.dir, lockDir))
+}
+
+func (f *Fake) load() (*state, error) {
+	st := &state{}
+
+	if content, err := os.ReadFile(filepath.Join(f.dir, stateFile)); err != nil {
+		return nil, err
+	} else if err = json.Unmarshal(content, st); err != nil {
+		return nil, err
+	}
+
+	if st.Running == nil {
+		st.Running = make(map[string]bool)
+	}
+
+	if st.Failures == nil {
+		st.Failures = make(map[string]*Failure)
+	}
+
+	return st, nil
+}
+
+func (f *Fake) save(st *state) error {
+	if content, err := json.Marshal(st); err != nil {
+		return err
+	} else {
+		return os.WriteFile(filepath.Join(f.dir, stateFile), content, 0644)
+	}
+}
+
+func copyFile(src, dst string, perm os.FileMode) error {
+	if content, err := os.ReadFile(src); err != nil {
+		return err
+	} else {
+		return os.WriteFile(dst, content, perm)
+	}
+}
@@ -0,0 +1,138 @@
+package fakevmrun
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const (
+	sectorSize  = 512
+	sparseMagic = "KDMV"
+)
+
+// vdiskManagerCommand name the operation from its flag
+func vdiskManagerCommand(args []string) (string, []string) {
+	if len(args) > 0 {
+		switch args[0] {
+		case "-c":
+			return "create", args[1:]
+		case "-x":
+			return "expand", args[1:]
+		}
+	}
+
+	return "unknown", args
+}
+
+func parseSize(size string) (uint64, error) {
+	units := map[string]uint64{"KB": 1024, "MB": 1024 * 1024, "GB": 1024 * 1024 * 1024}
+	upper := strings.ToUpper(size)
+
+	for suffix, unit := range units {
+		if strings.HasSuffix(upper, suffix) {
+			if value, err := strconv.ParseUint(strings.TrimSuffix(upper, suffix), 10, 64); err == nil {
+				return value * unit / sectorSize, nil
+			}
+		}
+	}
+
+	return 0, fmt.Errorf("Invalid disk size: %s", size)
+}
+
+// writeHeader write a sparse extent header, only the capacity is meaningful
+func writeHeader(vmdk string, sectors uint64, create bool) error {
+	flags := os.O_WRONLY
+
+	if create {
+		flags |= os.O_CREATE | os.O_EXCL
+	}
+
+	file, err := os.OpenFile(vmdk, flags, 0644)
+
+	if err != nil {
+		return err
+	}
+
+	defer file.Close()
+
+	header := make([]byte, sectorSize)
+
+	copy(header, sparseMagic)
+	binary.LittleEndian.PutUint32(header[4:8], 1)
+	binary.LittleEndian.PutUint64(header[12:20], sectors)
+
+	_, err = file.Write(header)
+
+	return err
+}
+
+func readCapacity(vmdk string) (uint64, error) {
+	file, err := os.Open(vmdk)
+
+	if err != nil {
+		return 0, err
+	}
+
+	defer file.Close()
+
+	header := make([]byte, 20)
+
+	if _, err = io.ReadFull(file, header); err != nil || string(header[:4]) != sparseMagic {
+		return 0, fmt.Errorf("The file specified is not a virtual disk: %s", vmdk)
+	}
+
+	return binary.LittleEndian.Uint64(header[12:20]), nil
+}
+
+func vdiskManager(command string, args []string) (string, error) {
+	if len(args) == 0 {
+		return "", fmt.Errorf("Invalid arguments for operation: %s", command)
+	}
+
+	vmdk := args[len(args)-1]
+
+	switch command {
+	case "create":
+		size := ""
+		adapter := ""
+
+		for i := 0; i < len(args)-2; i++ {
+			if args[i] == "-s" {
+				size = args[i+1]
+			} else if args[i] == "-a" {
+				adapter = args[i+1]
+			}
+		}
+
+		// The adapter default to ide
+		if adapter != "" && adapter != "ide" && adapter != "buslogic" && adapter != "lsilogic" {
+			return "", fmt.Errorf("Invalid adapter type: %s", adapter)
+		} else if sectors, err := parseSize(size); err != nil {
+			return "", err
+		} else if err = writeHeader(vmdk, sectors, true); err != nil {
+			return "", fmt.Errorf("Failed to create virtual disk: %v", err)
+		}
+
+		return "Virtual disk creation successful.\n", nil
+	case "expand":
+		if len(args) != 2 {
+			return "", fmt.Errorf("Invalid arguments for operation: %s", command)
+		} else if sectors, err := parseSize(args[0]); err != nil {
+			return "", err
+		} else if capacity, err := readCapacity(vmdk); err != nil {
+			return "", err
+		} else if sectors < capacity {
+			return "", fmt.Errorf("Failed to expand the disk '%s': The new size is smaller than the current size", vmdk)
+		} else if err = writeHeader(vmdk, sectors, false); err != nil {
+			return "", fmt.Errorf("Failed to expand the disk '%s': %v", vmdk, err)
+		}
+
+		return "Disk expansion completed successfully.\n", nil
+	}
+
+	return "", fmt.Errorf("Unrecognized operation: %s", command)
+}
@@ -0,0 +1,171 @@
+package fakevmrun
+
+import (
+	"fmt"
+	"hash/fnv"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var displayNameRegexp = regexp.MustCompile(`(?mi)^displayName\s*=.*$`)
+
+// vmrunCommand skip the host and guest credentials options in front of the command
+func vmrunCommand(args []string) (string, []string) {
+	for len(args) > 1 && strings.HasPrefix(args[0], "-") {
+		args = args[2:]
+	}
+
+	if len(args) == 0 {
+		return "", args
+	}
+
+	return args[0], args[1:]
+}
+
+func notPoweredOn(vmx string) error {
+	return fmt.Errorf("Error: The virtual machine is not powered on: %s", vmx)
+}
+
+func cannotOpen(vmx string) error {
+	return fmt.Errorf("Error: Cannot open VM: %s, unknown file", vmx)
+}
+
+// guestIPAddress derive a stable address from the VMX path
+func guestIPAddress(vmx string) string {
+	h := fnv.New32a()
+	h.Write([]byte(vmx))
+	sum := h.Sum32()
+
+	return fmt.Sprintf("172.16.%d.%d", (sum>>8)%254+1, sum%254+1)
+}
+
+func (f *Fake) vmrun(command string, args []string) (string, error) {
+	if command == "list" {
+		return f.list()
+	} else if len(args) == 0 {
+		return "", fmt.Errorf("Error: Invalid arguments for command: %s", command)
+	}
+
+	vmx := args[0]
+
+	if _, err := os.Stat(vmx); err != nil {
+		return "", cannotOpen(vmx)
+	}
+
+	switch command {
+	case "start":
+		return "", f.SetRunning(vmx, true)
+	case "stop":
+		if !f.Running(vmx) {
+			return "", notPoweredOn(vmx)
+		}
+
+		return "", f.SetRunning(vmx, false)
+	case "clone":
+		return "", clone(vmx, args[1:])
+	case "deleteVM":
+		if f.Running(vmx) {
+			return "", fmt.Errorf("Error: The virtual machine is in use: %s", vmx)
+		}
+
+		return "", deleteVM(vmx)
+	case "getGuestIPAddress":
+		if !f.Running(vmx) {
+			return "", fmt.Errorf("Error: The VMware Tools are not running in the virtual machine: %s", vmx)
+		}
+
+		return guestIPAddress(vmx) + "\n", nil
+	case "checkToolsState":
+		if f.Running(vmx) {
+			return "running\n", nil
+		}
+
+		return "installed\n", nil
+	}
+
+	return "", fmt.Errorf("Error: Unrecognized command: %s", command)
+}
+
+func (f *Fake) list() (string, error) {
+	var running []string
+
+	err := f.update(func(st *state) error {
+		for vmx := range st.Running {
+			running = append(running, vmx)
+		}
+
+		return nil
+	})
+
+	sort.Strings(running)
+
+	return fmt.Sprintf("Total running VMs: %d\n%s", len(running), strings.Join(append(running, ""), "\n")), err
+}
+
+// clone copy the VMX only, the disks aren't needed by the tests
+func clone(vmx string, args []string) error {
+	if len(args) < 2 {
+		return fmt.Errorf("Error: Invalid arguments for command: clone")
+	}
+
+	target := args[0]
+	name := strings.TrimSuffix(filepath.Base(target), filepath.Ext(target))
+	snapshot := ""
+
+	for _, option := range args[2:] {
+		if strings.HasPrefix(option, "-cloneName=") {
+			name = strings.TrimPrefix(option, "-cloneName=")
+		} else if strings.HasPrefix(option, "-snapshot=") {
+			snapshot = strings.TrimPrefix(option, "-snapshot=")
+		}
+	}
+
+	if args[1] == "linked" && snapshot == "" {
+		return fmt.Errorf("Error: A snapshot is required to create a linked clone")
+	} else if args[1] != "linked" && args[1] != "full" {
+		return fmt.Errorf("Error: Invalid clone type: %s", args[1])
+	} else if _, err := os.Stat(target); err == nil {
+		return fmt.Errorf("Error: The destination file already exists: %s", target)
+	} else if content, err := os.ReadFile(vmx); err != nil {
+		return cannotOpen(vmx)
+	} else if err = os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return fmt.Errorf("Error: %v", err)
+	} else {
+		displayName := fmt.Sprintf("displayName = \"%s\"", name)
+
+		if displayNameRegexp.Match(content) {
+			content = displayNameRegexp.ReplaceAll(content, []byte(displayName))
+		} else {
+			content = append(content, []byte(displayName+"\n")...)
+		}
+
+		if err = os.WriteFile(target, content, 0644); err != nil {
+			return fmt.Errorf("Error: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// deleteVM remove the files of the VM and its directory once empty
+func deleteVM(vmx string) error {
+	stem := strings.TrimSuffix(filepath.Base(vmx), filepath.Ext(vmx))
+
+	for _, pattern := range []string{stem + ".*", stem + "-*"} {
+		files, _ := filepath.Glob(filepath.Join(filepath.Dir(vmx), pattern))
+
+		for _, file := range files {
+			if err := os.RemoveAll(file); err != nil {
+				return fmt.Errorf("Error: %v", err)
+			}
+		}
+	}
+
+	// Fail silently when other VMs share the directory
+	os.Remove(filepath.Dir(vmx))
+
+	return nil
+}
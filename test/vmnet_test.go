@@ -0,0 +1,56 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/driver"
+	vagrant_driver "github.com/hashicorp/vagrant-vmware-desktop/go_src/vagrant-vmware-utility/driver"
+)
+
+func TestPrepareVmnet(t *testing.T) {
+	vmnets := &vagrant_driver.Vmnets{
+		Num: 3,
+		Vmnets: []*vagrant_driver.Vmnet{
+			{Name: "vmnet0", Type: "bridged"},
+			{Name: "vmnet1", Type: "hostOnly", Dhcp: "true", Subnet: "192.168.1.0", Mask: "255.255.255.0"},
+			{Name: "vmnet8", Type: "nat", Dhcp: "true", Subnet: "172.16.0.0", Mask: "255.255.0.0"},
+		},
+	}
+
+	invalids := map[string]*vagrant_driver.Vmnet{
+		"bridged":         {Name: "vmnet2", Type: "bridged"},
+		"mask only":       {Name: "vmnet2", Mask: "255.255.255.0"},
+		"bad subnet":      {Name: "vmnet2", Subnet: "192.168.300.0", Mask: "255.255.255.0"},
+		"bad mask":        {Name: "vmnet2", Subnet: "192.168.2.0", Mask: "255.0.255.0"},
+		"host bits":       {Name: "vmnet2", Subnet: "192.168.2.1", Mask: "255.255.255.0"},
+		"same subnet":     {Name: "vmnet2", Subnet: "192.168.1.0", Mask: "255.255.255.0"},
+		"inside subnet":   {Name: "vmnet2", Subnet: "172.16.4.0", Mask: "255.255.255.0"},
+		"contain subnets": {Name: "vmnet2", Subnet: "192.168.0.0", Mask: "255.255.0.0"},
+		"ipv6":            {Name: "vmnet2", Subnet: "fd00::", Mask: "ffff:ffff::"},
+	}
+
+	for name, vnet := range invalids {
+		if err := driver.PrepareVmnet(vmnets, vnet); err == nil {
+			t.Errorf("%s must be rejected: %+v", name, vnet)
+		}
+	}
+
+	vnet := &vagrant_driver.Vmnet{Name: "vmnet2", Type: "hostonly", Dhcp: "TRUE", Subnet: "192.168.2.0", Mask: "255.255.255.0"}
+
+	if err := driver.PrepareVmnet(vmnets, vnet); err != nil {
+		t.Errorf("vmnet must be accepted: %v", err)
+	} else if vnet.Type != driver.VmnetHostOnly || vnet.Dhcp != "true" {
+		t.Errorf("vmnet not normalized: %+v", vnet)
+	}
+
+	// The updated vmnet doesn't overlap itself
+	update := &vagrant_driver.Vmnet{Name: "vmnet1", Type: "nat", Subnet: "192.168.1.128", Mask: "255.255.255.128"}
+
+	if err := driver.PrepareVmnet(vmnets, update); err != nil {
+		t.Errorf("update must be accepted: %v", err)
+	} else if found := driver.FindVmnet(vmnets, "vmnet8"); found == nil || found.Type != "nat" {
+		t.Errorf("unexpected vmnet: %+v", found)
+	} else if driver.FindVmnet(vmnets, "vmnet9") != nil {
+		t.Error("unknown vmnet must not be found")
+	}
+}
@@ -0,0 +1,115 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/Fred78290/vmrest-go-client/client"
+	"github.com/Fred78290/vmrest-go-client/client/model"
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/test/fakevmrest"
+)
+
+func errorCode(err error) int {
+	if ge, ok := err.(client.GenericSwaggerError); ok {
+		if me, ok := ge.Model().(model.ErrorModel); ok {
+			return me.Code
+		}
+	}
+
+	return 0
+}
+
+func TestFakeVmrestNotPowered(t *testing.T) {
+	backend := newFakeBackend(t, 1, 0)
+	vmuuid := backend.vms[0].Id
+
+	if c, err := backend.srv.Client(); err != nil {
+		t.Fatalf("vmrest api client failed: %v", err)
+	} else if _, err = c.GetIPAddress(vmuuid); errorCode(err) != fakevmrest.ErrorNotPowered {
+		t.Errorf("expected not powered error, got: %v", err)
+	} else if vm, err := backend.vmrun(t).VirtualMachineByUUID(vmuuid); err != nil {
+		t.Errorf("not powered error must be ignored: %v", err)
+	} else if vm.Address != "" {
+		t.Errorf("unexpected address for a powered off vm: %s", vm.Address)
+	}
+}
+
+func TestFakeVmrestInjectedFailure(t *testing.T) {
+	backend := newFakeBackend(t, 1, 0)
+	vmuuid := backend.vms[0].Id
+	vmrun := backend.vmrun(t)
+
+	backend.srv.Fail("GET", "/api/vms/*/ip", fakevmrest.Failure{Times: 1, Code: 1, Message: "scripted failure"})
+
+	if _, err := vmrun.VirtualMachineByUUID(vmuuid); err == nil {
+		t.Error("scripted failure not raised")
+	} else if _, err = vmrun.VirtualMachineByUUID(vmuuid); err != nil {
+		t.Errorf("scripted failure raised twice: %v", err)
+	}
+}
+
+func TestFakeVmrestNetworkInterfaces(t *testing.T) {
+	backend := newFakeBackend(t, 1, 0)
+	vmuuid := backend.vms[0].Id
+	vmrun := backend.vmrun(t)
+
+	if c, err := backend.srv.Client(); err != nil {
+		t.Fatalf("vmrest api client failed: %v", err)
+	} else if networks, err := vmrun.ListNetworks(); err != nil {
+		t.Errorf("failed to list networks: %v", err)
+	} else if len(networks) != 2 {
+		t.Errorf("unexpected networks count, expected: 2, got: %d", len(networks))
+	} else if err = vmrun.AddNetworkInterface(vmuuid, "vmnet8"); err != nil {
+		t.Errorf("failed to add nat interface: %v", err)
+	} else if err = vmrun.AddNetworkInterface(vmuuid, "vmnet1"); err != nil {
+		t.Errorf("failed to add host-only interface: %v", err)
+	} else if err = vmrun.ChangeNetworkInterface(vmuuid, "vmnet8", 2); err != nil {
+		t.Errorf("failed to change interface: %v", err)
+	} else if nics, err := c.GetAllNICDevices(vmuuid); err != nil {
+		t.Errorf("failed to list nics: %v", err)
+	} else if nics.Num != 2 || nics.Nics[0].Type != "nat" || nics.Nics[1].Type != "nat" {
+		t.Errorf("unexpected nics: %v", nics.Nics)
+	} else if err = vmrun.AddNetworkInterface(vmuuid, "vmnet9"); err == nil {
+		t.Error("add interface on unknown vmnet must fail")
+	}
+}
+
+func TestFakeVmrestHostNetworks(t *testing.T) {
+	srv := fakevmrest.New()
+	defer srv.Close()
+
+	c, err := srv.Client()
+
+	if err != nil {
+		t.Fatalf("vmrest api client failed: %v", err)
+	}
+
+	forward := &model.PortforwardParameter{GuestIp: "192.168.2.10", GuestPort: 22, Desc: "ssh"}
+
+	if network, err := c.CreateNetwork(&model.CreateVmnetParameter{Name: "vmnet2"}); err != nil {
+		t.Errorf("failed to create vmnet: %v", err)
+	} else if network.Type != "hostOnly" {
+		t.Errorf("unexpected vmnet type: %s", network.Type)
+	} else if _, err = c.CreateNetwork(&model.CreateVmnetParameter{Name: "vmnet2"}); err == nil {
+		t.Error("duplicate vmnet must fail")
+	} else if _, err = c.UpdateMacToIP("vmnet8", "00:50:56:00:00:01", &model.MacToIpParameter{IP: "192.168.2.10"}); err != nil {
+		t.Errorf("failed to reserve ip: %v", err)
+	} else if _, err = c.UpdateMacToIP("vmnet8", "00:50:56:00:00:02", &model.MacToIpParameter{IP: "192.168.2.10"}); err == nil {
+		t.Error("duplicate reservation must fail")
+	} else if mactoips, err := c.GetMACToIPs("vmnet8"); err != nil {
+		t.Errorf("failed to list reservations: %v", err)
+	} else if mactoips.Num != 1 || mactoips.Mactoips[0].Ip != "192.168.2.10" {
+		t.Errorf("unexpected reservations: %v", mactoips.Mactoips)
+	} else if _, err = c.UpdatePortforward("vmnet1", "tcp", 2222, forward); err == nil {
+		t.Error("port forward on host-only vmnet must fail")
+	} else if _, err = c.UpdatePortforward("vmnet8", "tcp", 2222, forward); err != nil {
+		t.Errorf("failed to add port forward: %v", err)
+	} else if forwards, err := c.GetPortforwards("vmnet8"); err != nil {
+		t.Errorf("failed to list port forwards: %v", err)
+	} else if forwards.Num != 1 || forwards.PortForwardings[0].Guest.Port != 22 {
+		t.Errorf("unexpected port forwards: %v", forwards.PortForwardings)
+	} else if err = c.DeletePortforward("vmnet8", "tcp", 2222); err != nil {
+		t.Errorf("failed to delete port forward: %v", err)
+	} else if err = c.DeletePortforward("vmnet8", "tcp", 2222); errorCode(err) != fakevmrest.ErrorNotFound {
+		t.Errorf("expected not found error, got: %v", err)
+	}
+}
@@ -12,6 +12,7 @@ import (
 )
 
 func TestWithVMRestEmbeded(t *testing.T) {
+	requireVMware(t)
 
 	if _, err := loadConfig(); err != nil {
 		t.Errorf("unable to load config: %v", err)
@@ -0,0 +1,65 @@
+package service_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/service"
+)
+
+// benchModes compare the shared vmrun list result with the baseline spawning vmrun list for every lookup
+var benchModes = []struct {
+	name    string
+	options []service.Option
+}{
+	{name: "baseline", options: []service.Option{service.WithRunningVMTTL(0)}},
+	{name: "shared"},
+}
+
+func BenchmarkListVirtualMachines(b *testing.B) {
+	for _, mode := range benchModes {
+		for _, count := range []int{10, 30, 100} {
+			b.Run(fmt.Sprintf("%s/vms-%d", mode.name, count), func(b *testing.B) {
+				backend := newFakeBackend(b, count, 2)
+				vmrun := backend.vmrun(b, mode.options...)
+
+				b.ResetTimer()
+
+				for i := 0; i < b.N; i++ {
+					if _, err := vmrun.ListVirtualMachines(); err != nil {
+						b.Fatalf("failed to list vm: %v", err)
+					}
+				}
+
+				b.ReportMetric(float64(backend.fake.Calls("list"))/float64(b.N), "vmrun-list/op")
+			})
+		}
+	}
+}
+
+func BenchmarkPowerState(b *testing.B) {
+	for _, mode := range benchModes {
+		b.Run(mode.name, func(b *testing.B) {
+			backend := newFakeBackend(b, 30, 2)
+			vmrun := backend.vmrun(b, mode.options...)
+
+			if _, err := vmrun.ListVirtualMachines(); err != nil {
+				b.Fatalf("failed to list vm: %v", err)
+			}
+
+			calls := backend.fake.Calls("list")
+
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				for _, vm := range backend.vms {
+					if _, err := vmrun.PowerState(vm.Id); err != nil {
+						b.Fatalf("failed to get power state: %v", err)
+					}
+				}
+			}
+
+			b.ReportMetric(float64(backend.fake.Calls("list")-calls)/float64(b.N), "vmrun-list/op")
+		})
+	}
+}
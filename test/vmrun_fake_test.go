@@ -0,0 +1,915 @@
+package service_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Fred78290/vmrest-go-client/client/model"
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/service"
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/status"
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/test/fakevmrest"
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/utils"
+	codes "google.golang.org/grpc/codes"
+)
+
+func TestFakeLifecycle(t *testing.T) {
+	backend := newFakeBackend(t, 1, 0)
+	vmrun := backend.vmrun(t)
+
+	failOnError := func(message string, err error) {
+		t.Fatalf(message, err)
+	}
+
+	if vm, err := vmrun.Create(&service.CreateVirtualMachine{Template: backend.vms[0].Id, Name: "lifecycle", Vcpus: 2, Memory: 2048}); err != nil {
+		failOnError("failed to create vm: %v", err)
+	} else if disk, err := vmrun.AddDisk(vm.Uuid, &service.CreateVirtualDisk{Controller: "scsi", SizeInMb: 1024}); err != nil {
+		failOnError("failed to add disk: %v", err)
+	} else if disk.SizeInMb != 1024 {
+		t.Errorf("unexpected disk size, expected: 1024, got: %d", disk.SizeInMb)
+	} else if _, err := vmrun.Reconfigure(vm.Uuid, &service.ReconfigureVirtualMachine{DiskSizeInMb: 2048}); err != nil {
+		failOnError("failed to expand disk: %v", err)
+	} else if disks, err := vmrun.ListDisks(vm.Uuid); err != nil {
+		failOnError("failed to list disks: %v", err)
+	} else if len(disks) != 1 || disks[0].SizeInMb != 2048 {
+		t.Errorf("unexpected disks after expand: %v", disks)
+	} else if _, err := vmrun.Reconfigure(vm.Uuid, &service.ReconfigureVirtualMachine{DiskSizeInMb: 2048}); err == nil {
+		t.Error("expand to the same size must fail")
+	} else if st, _ := status.FromError(err); st.Code() != codes.InvalidArgument {
+		t.Errorf("unexpected error on same size expand: %v", err)
+	} else if _, err := vmrun.Reconfigure(vm.Uuid, &service.ReconfigureVirtualMachine{DiskSizeInMb: 1024}); err == nil {
+		t.Error("shrink must fail")
+	} else if _, err := vmrun.PowerOn(vm.Uuid); err != nil {
+		failOnError("failed to poweron vm: %v", err)
+	} else if _, err := vmrun.WaitForToolsRunning(vm.Uuid, time.Second); err != nil {
+		failOnError("failed to wait tools vm: %v", err)
+	} else if addresses, err := vmrun.WaitForIP(vm.Uuid, "", time.Second); err != nil {
+		failOnError("failed to wait ip vm: %v", err)
+	} else if addresses.Address == "" {
+		t.Error("empty ip address")
+	} else if _, err := vmrun.Delete(vm.Uuid); err == nil {
+		t.Error("delete of a running vm must fail")
+	} else if _, err := vmrun.PowerOff(vm.Uuid, "hard"); err != nil {
+		failOnError("failed to poweroff vm: %v", err)
+	} else if _, err := vmrun.Delete(vm.Uuid); err != nil {
+		failOnError("failed to delete vm: %v", err)
+	} else if _, err := vmrun.VirtualMachineByUUID(vm.Uuid); err == nil {
+		t.Error("vm still exists after delete")
+	}
+}
+
+func TestFakeScriptedFailure(t *testing.T) {
+	backend := newFakeBackend(t, 1, 0)
+	vmrun := backend.vmrun(t)
+	vmuuid := backend.vms[0].Id
+
+	if err := backend.fake.Fail("start", 1, "Error: scripted failure"); err != nil {
+		t.Fatalf("failed to script failure: %v", err)
+	}
+
+	if _, err := vmrun.PowerOn(vmuuid); err == nil {
+		t.Error("scripted failure not raised")
+	} else if _, err := vmrun.PowerOn(vmuuid); err != nil {
+		t.Errorf("scripted failure raised twice: %v", err)
+	} else if !backend.running(vmuuid) {
+		t.Error("vm not running after power on")
+	} else if calls := backend.fake.Calls("start"); calls != 2 {
+		t.Errorf("unexpected start calls, expected: 2, got: %d", calls)
+	}
+}
+
+func TestFakeAutostartSuspended(t *testing.T) {
+	backend := newFakeBackend(t, 1, 0)
+	vmrun := backend.vmrun(t)
+	vmuuid := backend.vms[0].Id
+
+	if _, err := vmrun.SetAutoStart(vmuuid, true); err != nil {
+		t.Fatalf("failed to set autostart: %v", err)
+	}
+
+	// A suspended VM has a checkpoint and no running process
+	if vmx, err := utils.LoadVMX(backend.vms[0].Path); err != nil {
+		t.Fatalf("failed to load vmx: %v", err)
+	} else if vmx.Set("checkpoint.vmState", "vm-0.vmss"); vmx.Save(backend.vms[0].Path) != nil {
+		t.Fatalf("failed to save vmx: %v", err)
+	} else if vm, err := vmrun.VirtualMachineByUUID(vmuuid); err != nil || vm.PowerState != service.Suspended {
+		t.Fatalf("vm must be suspended: %v, %v", vm, err)
+	} else if body, err := json.Marshal(vm); err != nil || !strings.Contains(string(body), `"powered":false`) {
+		t.Errorf("powered flag missing: %s, %v", body, err)
+	}
+
+	if err := vmrun.StartAutostartVM(); err != nil {
+		t.Fatalf("failed to autostart: %v", err)
+	} else if vm, err := vmrun.VirtualMachineByUUID(vmuuid); err != nil || vm.PowerState != service.PoweredOn {
+		t.Errorf("suspended autostart vm must be resumed: %v, %v", vm, err)
+	} else if body, err := json.Marshal(vm); err != nil || !strings.Contains(string(body), `"powered":true`) || !strings.Contains(string(body), `"powerState":"poweredOn"`) {
+		t.Errorf("unexpected json: %s, %v", body, err)
+	}
+}
+
+func TestFakeLinkedCloneCleanup(t *testing.T) {
+	backend := newFakeBackend(t, 1, 0)
+	vmrun := backend.vmrun(t)
+	template := backend.vms[0]
+	vmsd := strings.TrimSuffix(template.Path, ".vmx") + ".vmsd"
+	request := func(networks int, diskSizeInMb int) *service.CreateVirtualMachine {
+		result := &service.CreateVirtualMachine{Template: template.Id, Name: "linked", Vcpus: 2, Memory: 2048, Linked: true, DiskSizeInMb: diskSizeInMb}
+
+		for i := 0; i < networks; i++ {
+			result.Networks = append(result.Networks, &service.NetworkInterface{ConnectionType: "nat", Device: "vmxnet3"})
+		}
+
+		return result
+	}
+
+	// The base snapshot exists, the fake vmrun doesn't take snapshots
+	content := "snapshot.lastUID = \"1\"\nsnapshot.current = \"1\"\nsnapshot.numSnapshots = \"1\"\nsnapshot0.uid = \"1\"\nsnapshot0.displayName = \"autoscaler-linked-base\"\n"
+
+	if err := os.WriteFile(vmsd, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write vmsd: %v", err)
+	}
+
+	if _, err := vmrun.Create(request(1, 4096)); err == nil {
+		t.Error("resize of a linked clone disk must be rejected")
+	} else if st, _ := status.FromError(err); st.Code() != codes.InvalidArgument {
+		t.Errorf("unexpected error for a linked clone resize: %v", err)
+	} else if _, err = vmrun.Create(request(utils.MaxEthernetCards+1, 0)); err == nil {
+		t.Error("too many networks must be rejected")
+	} else if _, err = os.Stat(filepath.Join(backend.dir, "linked")); !os.IsNotExist(err) {
+		t.Errorf("clone directory not removed: %v", err)
+	} else if vm, err := vmrun.Create(request(1, 0)); err != nil {
+		t.Errorf("failed to create linked clone after a failure: %v", err)
+	} else if vm.Name != "linked" {
+		t.Errorf("unexpected linked clone: %v", vm)
+	}
+}
+
+func TestFakeRestoreVMX(t *testing.T) {
+	backend := newFakeBackend(t, 1, 0)
+	vmrun := backend.vmrun(t)
+	vmuuid := backend.vms[0].Id
+
+	if vm, err := vmrun.Reconfigure(vmuuid, &service.ReconfigureVirtualMachine{Vcpus: 4, Memory: 4096}); err != nil {
+		t.Fatalf("failed to reconfigure vm: %v", err)
+	} else if vm.Vcpus != 4 {
+		t.Errorf("unexpected vcpus after reconfigure: %d", vm.Vcpus)
+	} else if vm, err = vmrun.RestoreVMX(vmuuid, 1); err != nil {
+		t.Fatalf("failed to restore vmx: %v", err)
+	} else if vm.Vcpus != 2 || vm.Memory != 2048 {
+		t.Errorf("unexpected sizing after restore: %d vcpus, %d memory", vm.Vcpus, vm.Memory)
+	} else if _, err = vmrun.RestoreVMX(vmuuid, 3); err == nil {
+		t.Error("restore without enough backups must fail")
+	} else if _, err = vmrun.PowerOn(vmuuid); err != nil {
+		t.Fatalf("failed to poweron vm: %v", err)
+	} else if _, err = vmrun.RestoreVMX(vmuuid, 1); err == nil {
+		t.Error("restore of a running vm must fail")
+	}
+}
+
+func TestFakeSizingSchema(t *testing.T) {
+	backend := newFakeBackend(t, 1, 0)
+	vmrun := backend.vmrun(t)
+	vmuuid := backend.vms[0].Id
+
+	if _, err := vmrun.Reconfigure(vmuuid, &service.ReconfigureVirtualMachine{Memory: 2050}); err == nil {
+		t.Error("memory not multiple of 4MB must be rejected")
+	} else if st, _ := status.FromError(err); st.Code() != codes.InvalidArgument {
+		t.Errorf("unexpected error on invalid memory: %v", err)
+	} else if _, err = vmrun.Create(&service.CreateVirtualMachine{Template: vmuuid, Name: "invalid", Vcpus: 2, Memory: 2050}); err == nil {
+		t.Error("create with memory not multiple of 4MB must be rejected")
+	} else if vm, err := vmrun.Reconfigure(vmuuid, &service.ReconfigureVirtualMachine{Memory: 4096}); err != nil {
+		t.Errorf("failed to reconfigure memory: %v", err)
+	} else if vm.Vcpus != 2 || vm.Memory != 4096 {
+		t.Errorf("unexpected sizing after reconfigure: %d vcpus, %d memory", vm.Vcpus, vm.Memory)
+	}
+}
+
+func TestFakeReconfigureDisk(t *testing.T) {
+	backend := newFakeBackend(t, 1, 0)
+	vmrun := backend.vmrun(t)
+	vmuuid := backend.vms[0].Id
+	sizing := func() (int, int) {
+		if vmx, err := utils.LoadVMX(backend.vms[0].Path); err != nil {
+			t.Fatalf("failed to load vmx: %v", err)
+		} else {
+			sizing := utils.ReadCPUMemory(vmx)
+
+			return sizing.Vcpus, sizing.MemoryInMb
+		}
+
+		return 0, 0
+	}
+
+	disk, err := vmrun.AddDisk(vmuuid, &service.CreateVirtualDisk{Controller: "scsi", SizeInMb: 1024})
+
+	if err != nil {
+		t.Fatalf("failed to add disk: %v", err)
+	} else if err = backend.fake.Fail("expand", 1, "Error: scripted failure"); err != nil {
+		t.Fatalf("failed to script failure: %v", err)
+	}
+
+	// A failed expansion keep the VMX untouched
+	if _, err = vmrun.Reconfigure(vmuuid, &service.ReconfigureVirtualMachine{Vcpus: 4, DiskSizeInMb: 2048}); err == nil {
+		t.Error("scripted expand failure not raised")
+	} else if vcpus, memory := sizing(); vcpus != 2 || memory != 2048 {
+		t.Errorf("sizing saved despite the expand failure: %d vcpus, %d memory", vcpus, memory)
+	}
+
+	// Turn the boot disk into the delta disk of a linked clone
+	delta := filepath.Join(filepath.Dir(backend.vms[0].Path), disk.FileName)
+
+	if err = os.WriteFile(delta, []byte("# Disk DescriptorFile\nparentFileNameHint=\"base.vmdk\"\nRW 2097152 SPARSE \"delta-s001.vmdk\"\n"), 0644); err != nil {
+		t.Fatalf("failed to write delta disk: %v", err)
+	}
+
+	if _, err = vmrun.Reconfigure(vmuuid, &service.ReconfigureVirtualMachine{Vcpus: 4, DiskSizeInMb: 4096}); err == nil {
+		t.Error("expand of a delta disk must fail")
+	} else if st, _ := status.FromError(err); st.Code() != codes.FailedPrecondition {
+		t.Errorf("unexpected error on delta disk expand: %v", err)
+	} else if vcpus, _ := sizing(); vcpus != 2 {
+		t.Errorf("sizing saved despite the delta disk: %d vcpus", vcpus)
+	} else if calls := backend.fake.Calls("expand"); calls != 1 {
+		t.Errorf("unexpected expand calls, expected: 1, got: %d", calls)
+	}
+}
+
+func TestFakeDisks(t *testing.T) {
+	backend := newFakeBackend(t, 1, 0)
+	vmrun := backend.vmrun(t)
+	vmuuid := backend.vms[0].Id
+	slots := make([]string, 0, 4)
+
+	for _, controller := range []string{"scsi", "ide", "ide", "ide"} {
+		if disk, err := vmrun.AddDisk(vmuuid, &service.CreateVirtualDisk{Controller: controller, SizeInMb: 1024}); err != nil {
+			t.Fatalf("failed to add %s disk: %v", controller, err)
+		} else {
+			slots = append(slots, disk.Slot)
+		}
+	}
+
+	if strings.Join(slots, ",") != "scsi0:0,ide0:0,ide0:1,ide1:0" {
+		t.Fatalf("unexpected disk slots: %v", slots)
+	}
+
+	adapters := make([]string, 0, len(slots))
+
+	for _, args := range backend.fake.Invocations("create") {
+		for i := 0; i < len(args)-1; i++ {
+			if args[i] == "-a" {
+				adapters = append(adapters, args[i+1])
+			}
+		}
+	}
+
+	if strings.Join(adapters, ",") != "lsilogic,ide,ide,ide" {
+		t.Errorf("unexpected vmware-vdiskmanager adapters: %v", adapters)
+	} else if vmx, err := utils.LoadVMX(backend.vms[0].Path); err != nil {
+		t.Fatalf("failed to load vmx: %v", err)
+	} else if vmx.Get("scsi0.virtualDev") != "lsilogic" {
+		t.Errorf("scsi controller added without model: %q", vmx.Get("scsi0.virtualDev"))
+	}
+
+	disks, err := vmrun.ListDisks(vmuuid)
+
+	if err != nil {
+		t.Fatalf("failed to list disks: %v", err)
+	}
+
+	var delta string
+
+	for _, disk := range disks {
+		if disk.Slot == "ide1:0" {
+			delta = filepath.Join(filepath.Dir(backend.vms[0].Path), disk.FileName)
+		}
+	}
+
+	// Turn the last disk into the delta disk of a linked clone
+	if err = os.WriteFile(delta, []byte("# Disk DescriptorFile\nparentFileNameHint=\"base.vmdk\"\nRW 2097152 SPARSE \"delta-s001.vmdk\"\n"), 0644); err != nil {
+		t.Fatalf("failed to write delta disk: %v", err)
+	}
+
+	if _, err = vmrun.RemoveDisk(vmuuid, "scsi0:0", true); err == nil {
+		t.Error("delete of the boot disk must fail")
+	} else if st, _ := status.FromError(err); st.Code() != codes.FailedPrecondition {
+		t.Errorf("unexpected error on boot disk delete: %v", err)
+	} else if _, err = vmrun.RemoveDisk(vmuuid, "ide1:0", true); err == nil {
+		t.Error("delete of a delta disk must fail")
+	} else if _, err = vmrun.RemoveDisk(vmuuid, "ide1:0", false); err != nil {
+		t.Errorf("failed to detach delta disk: %v", err)
+	} else if !utils.FileExists(delta) {
+		t.Error("detached delta disk must be kept")
+	} else if _, err = vmrun.RemoveDisk(vmuuid, "ide0:1", true); err != nil {
+		t.Errorf("failed to remove disk: %v", err)
+	}
+}
+
+func TestFakeDiskBuses(t *testing.T) {
+	backend := newFakeBackend(t, 1, 0)
+	vmrun := backend.vmrun(t)
+	vmuuid := backend.vms[0].Id
+	content := "displayName = \"vm-0\"\nscsi0.present = \"TRUE\"\nscsi0.virtualDev = \"buslogic\"\nide0:0.present = \"TRUE\"\nide0:1.present = \"TRUE\"\nide1:0.present = \"TRUE\"\nide1:1.present = \"TRUE\"\n"
+
+	// Fill the first scsi bus
+	for unit := 0; unit < utils.DiskControllerUnits["scsi"]; unit++ {
+		if unit != 7 {
+			content += fmt.Sprintf("scsi0:%d.present = \"TRUE\"\n", unit)
+		}
+	}
+
+	if err := utils.ParseVMX(content).Save(backend.vms[0].Path); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := vmrun.AddDisk(vmuuid, &service.CreateVirtualDisk{Controller: "ide", SizeInMb: 1024}); err == nil {
+		t.Error("ide has only two buses, no slot must be free")
+	} else if st, _ := status.FromError(err); st.Code() != codes.ResourceExhausted {
+		t.Errorf("unexpected error on full ide: %v", err)
+	} else if disk, err := vmrun.AddDisk(vmuuid, &service.CreateVirtualDisk{Controller: "scsi", SizeInMb: 1024}); err != nil {
+		t.Fatalf("failed to add scsi disk: %v", err)
+	} else if disk.Slot != "scsi1:0" {
+		t.Errorf("unexpected scsi slot: %s", disk.Slot)
+	} else if disk, err = vmrun.AddDisk(vmuuid, &service.CreateVirtualDisk{Controller: "sata", SizeInMb: 1024}); err != nil {
+		t.Fatalf("failed to add sata disk: %v", err)
+	} else if disk.Slot != "sata0:0" {
+		t.Errorf("unexpected sata slot: %s", disk.Slot)
+	} else if vmx, err := utils.LoadVMX(backend.vms[0].Path); err != nil {
+		t.Fatalf("failed to load vmx: %v", err)
+	} else if vmx.Get("scsi1.present") != "TRUE" || vmx.Get("scsi1.virtualDev") != "buslogic" {
+		t.Errorf("new scsi bus must get the model of the first one: %q", vmx.Get("scsi1.virtualDev"))
+	} else if vmx.Get("sata0.virtualDev") != "" {
+		t.Errorf("unexpected sata model: %q", vmx.Get("sata0.virtualDev"))
+	}
+
+	if invocations := backend.fake.Invocations("create"); len(invocations) != 2 {
+		t.Fatalf("unexpected vmware-vdiskmanager invocations: %v", invocations)
+	} else if args := strings.Join(invocations[0], " "); !strings.Contains(args, "-a buslogic") {
+		t.Errorf("unexpected adapter for a buslogic disk: %s", args)
+	} else if args = strings.Join(invocations[1], " "); !strings.Contains(args, "-a lsilogic") {
+		t.Errorf("unexpected adapter for a sata disk: %s", args)
+	}
+}
+
+func TestFakeCreateWithNetworks(t *testing.T) {
+	backend := newFakeBackend(t, 1, 0)
+	vmrun := backend.vmrun(t)
+	networks := []*service.NetworkInterface{
+		{ConnectionType: "nat", Device: "vmxnet3", MacAddress: "00:50:56:00:00:01"},
+		{ConnectionType: "hostonly", Device: "e1000e", MacAddress: "generated"},
+	}
+
+	if _, err := vmrun.Create(&service.CreateVirtualMachine{Template: backend.vms[0].Id, Name: "invalid", Vcpus: 2, Memory: 2048, Networks: []*service.NetworkInterface{{ConnectionType: "nat", Device: "rtl8139"}}}); err == nil {
+		t.Error("invalid network device must be rejected")
+	} else if vm, err := vmrun.Create(&service.CreateVirtualMachine{Template: backend.vms[0].Id, Name: "networks", Vcpus: 2, Memory: 2048, Networks: networks}); err != nil {
+		t.Fatalf("failed to create vm: %v", err)
+	} else if status, err := vmrun.Status(vm.Uuid); err != nil {
+		t.Fatalf("failed to get status: %v", err)
+	} else if len(status.EthernetCards) != 2 {
+		t.Fatalf("unexpected ethernet cards: %d", len(status.EthernetCards))
+	} else if card := status.EthernetCards[0]; card.MacAddress != "00:50:56:00:00:01" || card.AddressType != "static" || card.PciSlotNumber != 160 {
+		t.Errorf("unexpected first card: %+v", card)
+	} else if card := status.EthernetCards[1]; card.VirtualDev != "e1000e" || card.AddressType != "generated" || card.PciSlotNumber != 192 {
+		t.Errorf("unexpected second card: %+v", card)
+	}
+}
+
+func TestFakePreviewCreate(t *testing.T) {
+	backend := newFakeBackend(t, 1, 0)
+	vmrun := backend.vmrun(t)
+	template := backend.vms[0].Id
+	request := &service.CreateVirtualMachine{
+		Template:   template,
+		Name:       "preview",
+		Vcpus:      4,
+		Memory:     2048,
+		Networks:   []*service.NetworkInterface{{ConnectionType: "nat", Device: "vmxnet3"}},
+		GuestInfos: map[string]string{"metadata": "e30="},
+	}
+
+	vm, err := vmrun.VirtualMachineByUUID(template)
+
+	if err != nil {
+		t.Fatalf("failed to find template: %v", err)
+	}
+
+	before, _ := os.ReadFile(vm.Path)
+	diff, err := vmrun.PreviewCreate(request)
+
+	if err != nil {
+		t.Fatalf("failed to preview create: %v", err)
+	}
+
+	changed := map[string]utils.VMXChange{}
+	added := map[string]utils.VMXChange{}
+
+	for _, change := range diff.Changed {
+		changed[change.Key] = change
+	}
+
+	for _, change := range diff.Added {
+		added[change.Key] = change
+	}
+
+	if change := changed["displayname"]; change.Value != "preview" || change.Old != "vm-0" {
+		t.Errorf("unexpected displayName change: %+v", change)
+	} else if change := changed["numvcpus"]; change.Value != "4" || change.Old != "2" {
+		t.Errorf("unexpected numvcpus change: %+v", change)
+	} else if _, found := changed["memsize"]; found {
+		t.Error("unchanged memsize must not be reported")
+	} else if added["guestinfo.metadata"].Value != "e30=" {
+		t.Errorf("guestinfo not added: %v", diff.Added)
+	} else if added["ethernet0.virtualdev"].Value != "vmxnet3" {
+		t.Errorf("ethernet not added: %v", diff.Added)
+	} else if len(diff.Removed) != 0 {
+		t.Errorf("unexpected removed keys: %v", diff.Removed)
+	}
+
+	if after, _ := os.ReadFile(vm.Path); string(after) != string(before) {
+		t.Errorf("template changed by the preview: %q", after)
+	} else if _, err = vmrun.VirtualMachineByName("preview"); err == nil {
+		t.Error("preview must not create the VM")
+	}
+
+	request.Networks[0].Device = "rtl8139"
+
+	if _, err = vmrun.PreviewCreate(request); err == nil {
+		t.Error("invalid network device must be rejected")
+	}
+
+	request.Template = "unknown"
+
+	if _, err = vmrun.PreviewCreate(request); err == nil {
+		t.Error("unknown template must be rejected")
+	}
+}
+
+func TestFakeManyNetworkInterfaces(t *testing.T) {
+	backend := newFakeBackend(t, 1, 0)
+	vmrun := backend.vmrun(t)
+	networks := make([]*service.NetworkInterface, 0, utils.MaxEthernetCards+1)
+
+	for card := 0; card <= utils.MaxEthernetCards; card++ {
+		networks = append(networks, &service.NetworkInterface{ConnectionType: "nat", Device: "vmxnet3"})
+	}
+
+	if _, err := vmrun.Create(&service.CreateVirtualMachine{Template: backend.vms[0].Id, Name: "toomany", Vcpus: 2, Memory: 2048, Networks: networks}); err == nil {
+		t.Error("network interfaces beyond the platform maximum must be rejected")
+	}
+
+	vm, err := vmrun.Create(&service.CreateVirtualMachine{Template: backend.vms[0].Id, Name: "many", Vcpus: 2, Memory: 2048, Networks: networks[:7]})
+
+	if err != nil {
+		t.Fatalf("failed to create vm: %v", err)
+	} else if status, err := vmrun.Status(vm.Uuid); err != nil {
+		t.Fatalf("failed to get status: %v", err)
+	} else if len(status.EthernetCards) != 7 {
+		t.Fatalf("unexpected ethernet cards: %d", len(status.EthernetCards))
+	}
+
+	if err = vmrun.RemoveNetworkInterface(vm.Uuid, 0); err == nil {
+		t.Error("nic 0 must be rejected")
+	} else if err = vmrun.RemoveNetworkInterface(vm.Uuid, 3); err != nil {
+		t.Fatalf("failed to remove nic: %v", err)
+	} else if err = vmrun.RemoveNetworkInterface(vm.Uuid, 3); err == nil {
+		t.Error("removed nic must not be found")
+	} else if status, err := vmrun.Status(vm.Uuid); err != nil {
+		t.Fatalf("failed to get status: %v", err)
+	} else if len(status.EthernetCards) != 6 {
+		t.Errorf("unexpected ethernet cards after removal: %d", len(status.EthernetCards))
+	} else if err = vmrun.AddNetworkInterface(vm.Uuid, "vmnet8"); err != nil {
+		t.Fatalf("failed to add nic: %v", err)
+	} else if vmx, err := utils.LoadVMX(vm.Path); err != nil {
+		t.Fatalf("failed to load vmx: %v", err)
+	} else if !utils.StrToBool(vmx.Get("ethernet2.present")) || len(utils.ReadEthernets(vmx)) != 7 {
+		t.Errorf("removed slot not reused: %v", vmx.Keys())
+	}
+
+	if _, err = vmrun.PowerOn(vm.Uuid); err != nil {
+		t.Fatalf("failed to power on: %v", err)
+	} else if err = vmrun.RemoveNetworkInterface(vm.Uuid, 1); err == nil {
+		t.Error("nic removal of a running vm must fail")
+	}
+}
+
+func staticMacs(t *testing.T, vmrun service.Vmrun, vmuuid string) []string {
+	status, err := vmrun.Status(vmuuid)
+
+	if err != nil {
+		t.Fatalf("failed to get status: %v", err)
+	}
+
+	macs := make([]string, 0, len(status.EthernetCards))
+
+	for _, card := range status.EthernetCards {
+		if card.AddressType != "static" || !strings.HasPrefix(card.MacAddress, "00:50:56:") || card.MacAddress[9:11] > "3f" {
+			t.Errorf("unexpected card: %+v", card)
+		}
+
+		macs = append(macs, card.MacAddress)
+	}
+
+	return macs
+}
+
+func TestFakeStaticMacAddresses(t *testing.T) {
+	backend := newFakeBackend(t, 1, 0)
+	vmrun := backend.vmrun(t)
+	request := func(name string, macs ...string) *service.CreateVirtualMachine {
+		networks := make([]*service.NetworkInterface, 0, len(macs))
+
+		for _, mac := range macs {
+			networks = append(networks, &service.NetworkInterface{ConnectionType: "nat", Device: "vmxnet3", MacAddress: mac})
+		}
+
+		return &service.CreateVirtualMachine{Template: backend.vms[0].Id, Name: name, Vcpus: 2, Memory: 2048, Networks: networks}
+	}
+
+	// The preview derive the same addresses without reserving them
+	preview, err := vmrun.PreviewCreate(request("static", service.MacAddressStatic, service.MacAddressStatic))
+
+	if err != nil {
+		t.Fatalf("failed to preview: %v", err)
+	}
+
+	vm, err := vmrun.Create(request("static", service.MacAddressStatic, service.MacAddressStatic))
+
+	if err != nil {
+		t.Fatalf("failed to create vm: %v", err)
+	}
+
+	macs := staticMacs(t, vmrun, vm.Uuid)
+
+	if len(macs) != 2 || macs[0] == macs[1] {
+		t.Fatalf("unexpected mac addresses: %v", macs)
+	}
+
+	for _, change := range preview.Added {
+		if change.Key == "ethernet0.address" && change.Value != macs[0] {
+			t.Errorf("preview and create differ, expected: %s, got: %s", macs[0], change.Value)
+		}
+	}
+
+	if !utils.FileExists(filepath.Join(backend.config, "macaddresses.json")) {
+		t.Error("reservations not persisted")
+	} else if _, err = vmrun.Create(request("clash", macs[1])); err == nil {
+		t.Error("mac address clash must be rejected")
+	} else if st, _ := status.FromError(err); st.Code() != codes.AlreadyExists {
+		t.Errorf("unexpected error for a mac address clash: %v", err)
+	} else if _, err = vmrun.Delete(vm.Uuid); err != nil {
+		t.Fatalf("failed to delete vm: %v", err)
+	}
+
+	// A new process recreating the VM get the reserved addresses back
+	vmrun = backend.vmrun(t)
+
+	if vm, err = vmrun.Create(request("static", service.MacAddressStatic, service.MacAddressStatic)); err != nil {
+		t.Fatalf("failed to recreate vm: %v", err)
+	} else if recreated := staticMacs(t, vmrun, vm.Uuid); recreated[0] != macs[0] || recreated[1] != macs[1] {
+		t.Errorf("mac addresses not stable, expected: %v, got: %v", macs, recreated)
+	}
+
+	// The derived address is already used by the template, the allocator must probe the next one
+	if preview, err = vmrun.PreviewCreate(request("collide", service.MacAddressStatic)); err != nil {
+		t.Fatalf("failed to preview: %v", err)
+	}
+
+	derived := ""
+
+	for _, change := range preview.Added {
+		if change.Key == "ethernet0.address" {
+			derived = change.Value
+		}
+	}
+
+	if derived == "" {
+		t.Fatalf("no static address in preview: %v", preview.Added)
+	}
+
+	template := utils.ParseVMX(fmt.Sprintf("displayName = \"vm-0\"\nethernet0.present = \"TRUE\"\nethernet0.addressType = \"static\"\nethernet0.address = \"%s\"\n", derived))
+
+	if err = template.Save(backend.vms[0].Path); err != nil {
+		t.Fatal(err)
+	} else if vm, err = vmrun.Create(request("collide", service.MacAddressStatic)); err != nil {
+		t.Fatalf("failed to create vm: %v", err)
+	} else if collide := staticMacs(t, vmrun, vm.Uuid); collide[0] == derived {
+		t.Errorf("mac address clash with the template: %s", derived)
+	}
+}
+
+func TestFakeMacAddressUncachedVM(t *testing.T) {
+	backend := newFakeBackend(t, 1, 0)
+	vmrun := backend.vmrun(t)
+
+	// Only the template is cached
+	if _, err := vmrun.VirtualMachineByUUID(backend.vms[0].Id); err != nil {
+		t.Fatalf("failed to find template: %v", err)
+	}
+
+	// A powered off VM registered behind the back of the service
+	vmx := backend.addVM(t, "offline")
+	offline := utils.ParseVMX("displayName = \"offline\"\nethernet0.present = \"TRUE\"\nethernet0.addressType = \"static\"\nethernet0.address = \"00:50:56:00:00:20\"\n")
+
+	if err := offline.Save(vmx); err != nil {
+		t.Fatal(err)
+	}
+
+	networks := []*service.NetworkInterface{{ConnectionType: "nat", Device: "vmxnet3", MacAddress: "00:50:56:00:00:20"}}
+
+	if _, err := vmrun.Create(&service.CreateVirtualMachine{Template: backend.vms[0].Id, Name: "clash", Vcpus: 2, Memory: 2048, Networks: networks}); err == nil {
+		t.Error("mac address of a powered off VM must be rejected")
+	} else if st, _ := status.FromError(err); st.Code() != codes.AlreadyExists {
+		t.Errorf("unexpected error for a mac address clash: %v", err)
+	}
+}
+
+func TestFakePortForwards(t *testing.T) {
+	backend := newFakeBackend(t, 1, 0)
+	vmrun := backend.vmrun(t)
+	networks := []*service.NetworkInterface{{ConnectionType: "nat", Device: "vmxnet3", MacAddress: "00:50:56:00:00:10"}}
+
+	node, err := vmrun.Create(&service.CreateVirtualMachine{Template: backend.vms[0].Id, Name: "node", Vcpus: 2, Memory: 2048, Networks: networks})
+
+	if err != nil {
+		t.Fatalf("failed to create vm: %v", err)
+	}
+
+	networks[0].MacAddress = "00:50:56:00:00:11"
+
+	other, err := vmrun.Create(&service.CreateVirtualMachine{Template: backend.vms[0].Id, Name: "other", Vcpus: 2, Memory: 2048, Networks: networks})
+
+	if err != nil {
+		t.Fatalf("failed to create vm: %v", err)
+	}
+
+	backend.srv.SetIP(node.Uuid, "192.168.2.10")
+
+	if _, err = vmrun.PowerOn(node.Uuid); err != nil {
+		t.Fatalf("failed to power on: %v", err)
+	}
+
+	if _, err = vmrun.AddPortForward(node.Uuid, &service.PortForward{Protocol: "sctp", HostPort: 8080}); err == nil {
+		t.Error("invalid protocol must be rejected")
+	} else if _, err = vmrun.AddPortForward(node.Uuid, &service.PortForward{Vmnet: "vmnet1", HostPort: 8080}); err == nil {
+		t.Error("host-only vmnet must be rejected")
+	} else if forward, err := vmrun.AddPortForward(node.Uuid, &service.PortForward{HostPort: 8443, GuestPort: 443, Description: "ingress"}); err != nil {
+		t.Fatalf("failed to add port forward: %v", err)
+	} else if forward.GuestIP != "192.168.2.10" || forward.Vmnet != "vmnet8" || forward.Protocol != "tcp" {
+		t.Errorf("unexpected port forward: %+v", forward)
+	} else if forwards := backend.srv.Portforwards("vmnet8"); len(forwards) != 1 || !strings.Contains(forwards[0].Desc, node.Uuid) {
+		t.Errorf("port forward not tagged: %+v", forwards)
+	} else if _, err = vmrun.AddPortForward(other.Uuid, &service.PortForward{HostPort: 8443, GuestIP: "192.168.2.11"}); err == nil {
+		t.Error("host port forwarded to another vm must be rejected")
+	}
+
+	// The powered off VM address come from its DHCP reservation
+	if c, err := backend.srv.Client(); err != nil {
+		t.Fatalf("vmrest api client failed: %v", err)
+	} else if _, err = vmrun.AddPortForward(other.Uuid, &service.PortForward{HostPort: 2222, GuestPort: 22}); err == nil {
+		t.Error("vm without address must be rejected")
+	} else if _, err = c.UpdateMacToIP("vmnet8", "00:50:56:00:00:11", &model.MacToIpParameter{IP: "192.168.2.11"}); err != nil {
+		t.Fatalf("failed to reserve ip: %v", err)
+	} else if forward, err := vmrun.AddPortForward(other.Uuid, &service.PortForward{HostPort: 2222, GuestPort: 22}); err != nil {
+		t.Fatalf("failed to add port forward: %v", err)
+	} else if forward.GuestIP != "192.168.2.11" {
+		t.Errorf("unexpected guest address: %s", forward.GuestIP)
+	}
+
+	if forwards, err := vmrun.ListPortForwards(node.Uuid); err != nil {
+		t.Fatalf("failed to list port forwards: %v", err)
+	} else if len(forwards) != 1 || forwards[0].HostPort != 8443 || forwards[0].GuestPort != 443 || forwards[0].Description != "ingress" {
+		t.Errorf("unexpected port forwards: %+v", forwards)
+	} else if _, err = vmrun.DeletePortForward(node.Uuid, "tcp", 2222); err == nil {
+		t.Error("port forward of another vm must not be deleted")
+	} else if _, err = vmrun.DeletePortForward(node.Uuid, "tcp", 8443); err != nil {
+		t.Errorf("failed to delete port forward: %v", err)
+	} else if _, err = vmrun.Delete(other.Uuid); err != nil {
+		t.Fatalf("failed to delete vm: %v", err)
+	} else if forwards := backend.srv.Portforwards("vmnet8"); len(forwards) != 0 {
+		t.Errorf("port forwards not pruned: %+v", forwards)
+	}
+}
+
+func TestFakeDhcpReservations(t *testing.T) {
+	backend := newFakeBackend(t, 1, 0)
+	vmrun := backend.vmrun(t)
+	request := func(name string, addresses ...string) *service.CreateVirtualMachine {
+		networks := make([]*service.NetworkInterface, 0, len(addresses))
+
+		for _, address := range addresses {
+			networks = append(networks, &service.NetworkInterface{ConnectionType: "nat", Device: "vmxnet3", Address: address})
+		}
+
+		return &service.CreateVirtualMachine{Template: backend.vms[0].Id, Name: name, Vcpus: 2, Memory: 2048, Networks: networks}
+	}
+
+	vm, err := vmrun.Create(request("node", "192.168.2.20"))
+
+	if err != nil {
+		t.Fatalf("failed to create vm: %v", err)
+	}
+
+	macs := staticMacs(t, vmrun, vm.Uuid)
+
+	if mactoips := backend.srv.MacToIPs("vmnet8"); len(mactoips) != 1 || mactoips[0].Ip != "192.168.2.20" || !strings.EqualFold(mactoips[0].Mac, macs[0]) {
+		t.Errorf("unexpected dhcp reservations: %+v", mactoips)
+	}
+
+	for _, invalid := range []*service.CreateVirtualMachine{request("outside", "10.0.0.1"), request("broadcast", "192.168.2.255"), request("twice", "192.168.2.30", "192.168.2.30")} {
+		if _, err = vmrun.Create(invalid); err == nil {
+			t.Errorf("invalid address must be rejected for vm: %s", invalid.Name)
+		} else if st, _ := status.FromError(err); st.Code() != codes.InvalidArgument {
+			t.Errorf("unexpected error for vm: %s, %v", invalid.Name, err)
+		}
+	}
+
+	if _, err = vmrun.Create(request("clash", "192.168.2.20")); err == nil {
+		t.Error("address reserved by another vm must be rejected")
+	} else if st, _ := status.FromError(err); st.Code() != codes.AlreadyExists {
+		t.Errorf("unexpected error for an address clash: %v", err)
+	} else if _, err = vmrun.AllocateIP("vmnet8", "owner", "192.168.2.40"); err != nil {
+		t.Fatalf("failed to allocate address: %v", err)
+	} else if _, err = vmrun.Create(request("allocated", "192.168.2.40")); err == nil {
+		t.Error("address allocated to another vm must be rejected")
+	} else if st, _ := status.FromError(err); st.Code() != codes.AlreadyExists {
+		t.Errorf("unexpected error for an allocated address: %v", err)
+	} else if _, err = vmrun.Create(request("owner", "192.168.2.40")); err != nil {
+		t.Errorf("address allocated to the vm must be reserved: %v", err)
+	} else if _, err = vmrun.Delete(vm.Uuid); err != nil {
+		t.Fatalf("failed to delete vm: %v", err)
+	} else if mactoips := backend.srv.MacToIPs("vmnet8"); len(mactoips) != 1 {
+		t.Errorf("dhcp reservations not released: %+v", mactoips)
+	}
+}
+
+func TestFakeIPAM(t *testing.T) {
+	backend := newFakeBackend(t, 1, 0)
+	vmrun := backend.vmrun(t)
+	networks := []*service.NetworkInterface{{ConnectionType: "hostonly", Device: "vmxnet3"}}
+
+	vm, err := vmrun.Create(&service.CreateVirtualMachine{Template: backend.vms[0].Id, Name: "node", Vcpus: 2, Memory: 2048, Networks: networks})
+
+	if err != nil {
+		t.Fatalf("failed to create vm: %v", err)
+	}
+
+	// The first host address belong to the host adapter, the second to the NAT gateway
+	if allocated, err := vmrun.AllocateIP("vmnet1", "node", ""); err != nil {
+		t.Fatalf("failed to allocate address: %v", err)
+	} else if allocated.Address != "192.168.1.2" {
+		t.Errorf("unexpected address: %s", allocated.Address)
+	} else if allocated, err = vmrun.AllocateIP("vmnet8", "node", ""); err != nil {
+		t.Fatalf("failed to allocate address: %v", err)
+	} else if allocated.Address != "192.168.2.3" {
+		t.Errorf("unexpected address: %s", allocated.Address)
+	} else if allocated, err = vmrun.AllocateIP("vmnet1", "node", "192.168.1.2"); err != nil || allocated.Address != "192.168.1.2" {
+		t.Errorf("allocation must be idempotent for the same owner: %v", err)
+	}
+
+	for _, invalid := range []struct {
+		vmnet, owner, address string
+		code                  codes.Code
+	}{
+		{"vmnet1", "other", "192.168.1.2", codes.AlreadyExists},
+		{"vmnet1", "other", "192.168.1.1", codes.AlreadyExists},
+		{"vmnet1", "other", "192.168.1.130", codes.AlreadyExists},
+		{"vmnet8", "other", "192.168.2.254", codes.AlreadyExists},
+		{"vmnet1", "other", "10.0.0.1", codes.InvalidArgument},
+		{"vmnet1", "other", "192.168.1.255", codes.InvalidArgument},
+		{"vmnet1", "", "", codes.InvalidArgument},
+		{"vmnet9", "other", "", codes.NotFound},
+	} {
+		if _, err = vmrun.AllocateIP(invalid.vmnet, invalid.owner, invalid.address); err == nil {
+			t.Errorf("allocation must be rejected: %+v", invalid)
+		} else if st, _ := status.FromError(err); st.Code() != invalid.code {
+			t.Errorf("unexpected error for: %+v, %v", invalid, err)
+		}
+	}
+
+	// DHCP reservations are skipped
+	if c, err := backend.srv.Client(); err != nil {
+		t.Fatalf("vmrest api client failed: %v", err)
+	} else if _, err = c.UpdateMacToIP("vmnet1", "00:50:56:00:00:20", &model.MacToIpParameter{IP: "192.168.1.3"}); err != nil {
+		t.Fatalf("failed to reserve ip: %v", err)
+	} else if allocated, err := vmrun.AllocateIP("vmnet1", "other", ""); err != nil {
+		t.Fatalf("failed to allocate address: %v", err)
+	} else if allocated.Address != "192.168.1.4" {
+		t.Errorf("unexpected address: %s", allocated.Address)
+	}
+
+	// A new process get the allocations back
+	vmrun = backend.vmrun(t)
+
+	if allocations, err := vmrun.ListIPAllocations(""); err != nil {
+		t.Fatalf("failed to list allocations: %v", err)
+	} else if len(allocations) != 3 || allocations[0].Address != "192.168.1.2" || allocations[1].Address != "192.168.1.4" || allocations[2].Vmnet != "vmnet8" {
+		t.Errorf("allocations not persisted: %v", allocations)
+	} else if _, err = vmrun.ListIPAllocations("vmnet9"); err == nil {
+		t.Error("unknown vmnet must be rejected")
+	}
+
+	if _, err = vmrun.Delete(vm.Uuid); err != nil {
+		t.Fatalf("failed to delete vm: %v", err)
+	} else if allocations, _ := vmrun.ListIPAllocations(""); len(allocations) != 1 || allocations[0].Owner != "other" {
+		t.Errorf("allocations of the deleted vm not reclaimed: %v", allocations)
+	} else if _, err = vmrun.ReleaseIP("vmnet1", "192.168.1.2"); err == nil {
+		t.Error("release of a free address must be rejected")
+	} else if done, err := vmrun.ReleaseIP("vmnet1", "192.168.1.4"); err != nil || !done {
+		t.Errorf("failed to release address: %v", err)
+	} else if allocations, _ := vmrun.ListIPAllocations("vmnet1"); len(allocations) != 0 {
+		t.Errorf("address not released: %v", allocations)
+	}
+}
+
+func TestFakeWaitForNetworkIP(t *testing.T) {
+	backend := newFakeBackend(t, 1, 0)
+	vmrun := backend.vmrun(t)
+	networks := []*service.NetworkInterface{
+		{ConnectionType: "nat", Device: "vmxnet3", MacAddress: "00:50:56:00:00:30"},
+		{ConnectionType: "hostonly", Device: "vmxnet3", MacAddress: "00:50:56:00:00:31"},
+	}
+
+	vm, err := vmrun.Create(&service.CreateVirtualMachine{Template: backend.vms[0].Id, Name: "node", Vcpus: 2, Memory: 2048, Networks: networks})
+
+	if err != nil {
+		t.Fatalf("failed to create vm: %v", err)
+	} else if _, err = vmrun.WaitForIP(vm.Uuid, "", time.Second); err == nil {
+		t.Error("wait for ip of a powered off vm must fail")
+	} else if _, err = vmrun.PowerOn(vm.Uuid); err != nil {
+		t.Fatalf("failed to power on: %v", err)
+	}
+
+	backend.srv.SetIP(vm.Uuid, "192.168.2.10")
+	backend.srv.SetNicIP(vm.Uuid, 2, "192.168.1.10")
+
+	if addresses, err := vmrun.WaitForIP(vm.Uuid, "", time.Second); err != nil {
+		t.Fatalf("failed to wait ip: %v", err)
+	} else if addresses.Address != "192.168.2.10" || len(addresses.Interfaces) != 2 {
+		t.Errorf("unexpected addresses: %+v", addresses)
+	} else if addresses.Interfaces[1].Index != 2 || addresses.Interfaces[1].MacAddress != "00:50:56:00:00:31" || len(addresses.Interfaces[1].Addresses) != 1 {
+		t.Errorf("unexpected interface: %+v", addresses.Interfaces[1])
+	}
+
+	for _, network := range []string{"2", "00:50:56:00:00:31", "192.168.1.0/24"} {
+		if addresses, err := vmrun.WaitForIP(vm.Uuid, network, time.Second); err != nil {
+			t.Errorf("failed to wait ip on: %s, %v", network, err)
+		} else if addresses.Address != "192.168.1.10" {
+			t.Errorf("unexpected address on: %s, %s", network, addresses.Address)
+		}
+	}
+
+	if _, err = vmrun.WaitForIP(vm.Uuid, "eth1", time.Second); err == nil {
+		t.Error("invalid network must be rejected")
+	} else if st, _ := status.FromError(err); st.Code() != codes.InvalidArgument {
+		t.Errorf("unexpected error for an invalid network: %v", err)
+	} else if _, err = vmrun.WaitForIP(vm.Uuid, "10.0.0.0/8", time.Second); err == nil {
+		t.Error("wait for an address outside the guest subnets must time out")
+	}
+
+	// The index can't be resolved without the NIC devices
+	backend.srv.Fail("GET", "/api/vms/*/nic", fakevmrest.Failure{Times: 1, Code: 1, Message: "scripted failure"})
+
+	if _, err = vmrun.WaitForIP(vm.Uuid, "2", time.Second); err == nil {
+		t.Error("wait for a NIC index must fail when the NIC devices can't be listed")
+	} else if st, _ := status.FromError(err); st.Code() != codes.Internal {
+		t.Errorf("unexpected error without NIC devices: %v", err)
+	}
+}
+
+func TestFakeEvents(t *testing.T) {
+	backend := newFakeBackend(t, 1, 0)
+	vmrun := backend.vmrun(t)
+	vmuuid := backend.vms[0].Id
+
+	for round := 0; round < 2; round++ {
+		events, unsubscribe := vmrun.Subscribe()
+
+		// Let the watcher take its baseline
+		time.Sleep(500 * time.Millisecond)
+
+		if round == 0 {
+			if _, err := vmrun.PowerOn(vmuuid); err != nil {
+				t.Fatalf("failed to power on: %v", err)
+			}
+		} else if _, err := vmrun.PowerOff(vmuuid, "hard"); err != nil {
+			t.Fatalf("failed to power off: %v", err)
+		}
+
+		select {
+		case event := <-events:
+			if event.Uuid != vmuuid {
+				t.Errorf("unexpected event: %+v", event)
+			}
+		case <-time.After(15 * time.Second):
+			t.Errorf("no event received in round: %d", round)
+		}
+
+		unsubscribe()
+
+		// The channel is closed by unsubscribe, the watcher stop with the last subscriber
+		for range events {
+		}
+	}
+}
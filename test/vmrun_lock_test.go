@@ -0,0 +1,170 @@
+package service_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/service"
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/status"
+	codes "google.golang.org/grpc/codes"
+)
+
+// Those tests are meaningful with -race
+
+func TestParallelPowerOperations(t *testing.T) {
+	backend := newFakeBackend(t, 20, 0)
+	vmrun := backend.vmrun(t)
+
+	var wg sync.WaitGroup
+
+	for _, vm := range backend.vms {
+		wg.Add(1)
+
+		go func(vmuuid string) {
+			defer wg.Done()
+
+			if _, err := vmrun.PowerOn(vmuuid); err != nil {
+				t.Errorf("failed to power on vm: %s, reason: %v", vmuuid, err)
+			} else if state, err := vmrun.PowerState(vmuuid); err != nil {
+				t.Errorf("failed to get power state of vm: %s, reason: %v", vmuuid, err)
+			} else if state != service.PoweredOn {
+				t.Errorf("unexpected power state of vm: %s, got: %s", vmuuid, state)
+			} else if _, err := vmrun.Status(vmuuid); err != nil {
+				t.Errorf("failed to get status of vm: %s, reason: %v", vmuuid, err)
+			} else if _, err := vmrun.PowerOff(vmuuid, "hard"); err != nil {
+				t.Errorf("failed to power off vm: %s, reason: %v", vmuuid, err)
+			}
+		}(vm.Id)
+
+		// Concurrent readers
+		wg.Add(1)
+
+		go func(vmuuid string) {
+			defer wg.Done()
+
+			if _, err := vmrun.ListVirtualMachines(); err != nil {
+				t.Errorf("failed to list vm: %v", err)
+			} else if _, err := vmrun.VirtualMachineByUUID(vmuuid); err != nil {
+				t.Errorf("failed to find vm: %s, reason: %v", vmuuid, err)
+			}
+		}(vm.Id)
+	}
+
+	wg.Wait()
+
+	for _, vm := range backend.vms {
+		if backend.running(vm.Id) {
+			t.Errorf("vm: %s still running", vm.Id)
+		}
+	}
+}
+
+func TestParallelCreate(t *testing.T) {
+	backend := newFakeBackend(t, 1, 0)
+	vmrun := backend.vmrun(t)
+	template := backend.vms[0].Id
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func(name string) {
+			defer wg.Done()
+
+			if vm, err := vmrun.Create(&service.CreateVirtualMachine{Template: template, Name: name, Vcpus: 2, Memory: 2048}); err != nil {
+				t.Errorf("failed to create vm: %s, reason: %v", name, err)
+			} else if vm.Name != name {
+				t.Errorf("unexpected vm name, expected: %s, got: %s", name, vm.Name)
+			}
+		}(fmt.Sprintf("clone-%d", i))
+	}
+
+	wg.Wait()
+
+	if vms, err := vmrun.ListVirtualMachines(); err != nil {
+		t.Fatalf("failed to list vm: %v", err)
+	} else if len(vms) != 11 {
+		t.Errorf("unexpected vm count, expected: 11, got: %d", len(vms))
+	}
+}
+
+func TestParallelCreateSameName(t *testing.T) {
+	backend := newFakeBackend(t, 1, 0)
+	vmrun := backend.vmrun(t)
+	template := backend.vms[0].Id
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+
+	created := 0
+	exists := 0
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			_, err := vmrun.Create(&service.CreateVirtualMachine{Template: template, Name: "clone", Vcpus: 2, Memory: 2048})
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err == nil {
+				created++
+			} else if st, _ := status.FromError(err); st.Code() == codes.AlreadyExists {
+				exists++
+			} else {
+				t.Errorf("unexpected error: %v", err)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if created != 1 || exists != 9 {
+		t.Errorf("expected one creation, got: %d created, %d already exists", created, exists)
+	}
+}
+
+func TestParallelPortForwardsSameHostPort(t *testing.T) {
+	backend := newFakeBackend(t, 10, 0)
+	vmrun := backend.vmrun(t)
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+
+	added := 0
+	exists := 0
+
+	for i, vm := range backend.vms {
+		wg.Add(1)
+
+		go func(vmuuid, address string) {
+			defer wg.Done()
+
+			_, err := vmrun.AddPortForward(vmuuid, &service.PortForward{HostPort: 8080, GuestIP: address})
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err == nil {
+				added++
+			} else if st, _ := status.FromError(err); st.Code() == codes.AlreadyExists {
+				exists++
+			} else {
+				t.Errorf("unexpected error: %v", err)
+			}
+		}(vm.Id, fmt.Sprintf("192.168.2.%d", 10+i))
+	}
+
+	wg.Wait()
+
+	if added != 1 || exists != 9 {
+		t.Errorf("expected one port forward, got: %d added, %d already exists", added, exists)
+	} else if forwards := backend.srv.Portforwards("vmnet8"); len(forwards) != 1 {
+		t.Errorf("unexpected port forwards: %+v", forwards)
+	}
+}
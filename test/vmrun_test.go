@@ -91,15 +91,23 @@ func parseURL(urlStr string) (*url.URL, error) {
 
 func waitForPowerState(vmrun service.Vmrun, vmuuid string, wanted bool) error {
 	return utils.PollImmediate(time.Second, 0, func() (bool, error) {
-		if powered, err := vmrun.PowerState(vmuuid); err != nil {
+		if state, err := vmrun.PowerState(vmuuid); err != nil {
 			return false, err
 		} else {
-			return powered == wanted, nil
+			return state.Powered() == wanted, nil
 		}
 	})
 }
 
+// requireVMware skip the tests needing a real VMware desktop
+func requireVMware(t *testing.T) {
+	if os.Getenv("VMFOLDER") == "" {
+		t.Skip("VMFOLDER not defined, VMware desktop required")
+	}
+}
+
 func TestCreateVM(t *testing.T) {
+	requireVMware(t)
 
 	if config, err := loadConfig(); err != nil {
 		t.Errorf("unable to load config: %v", err)
@@ -168,7 +176,7 @@ func TestCreateVM(t *testing.T) {
 				failOnError(vm, "failed to wait poweroff vm: %v", err)
 			} else if _, err := vmrun.WaitForToolsRunning(vm.Uuid, configuration.Timeout); err != nil {
 				failOnError(vm, "failed to wait tools vm: %v", err)
-			} else if _, err := vmrun.WaitForIP(vm.Uuid, configuration.Timeout); err != nil {
+			} else if _, err := vmrun.WaitForIP(vm.Uuid, "", configuration.Timeout); err != nil {
 				failOnError(vm, "failed to wait ip vm: %v", err)
 			} else if _, err := vmrun.Status(vm.Uuid); err != nil {
 				failOnError(vm, "failed to get status vm: %v", err)
@@ -0,0 +1,145 @@
+package service_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/utils"
+)
+
+func TestVMXSchemaEthernet(t *testing.T) {
+	vmx := utils.ParseVMX("ethernet0.present = \"TRUE\"\nethernet0.generatedAddress = \"00:0c:29:00:00:01\"\nethernet0.uptCompatibility = \"TRUE\"\n")
+	ethernet := &utils.EthernetDevice{
+		Index:                0,
+		Present:              true,
+		VirtualDev:           "vmxnet3",
+		ConnectionType:       "custom",
+		Vnet:                 "vmnet2",
+		AddressType:          "static",
+		Address:              "00:50:56:00:00:01",
+		LinkStatePropagation: true,
+		PciSlotNumber:        160,
+	}
+
+	if err := ethernet.Write(vmx); err != nil {
+		t.Fatalf("failed to write ethernet: %v", err)
+	} else if read, found := utils.ReadEthernet(vmx, 0); !found {
+		t.Fatal("ethernet0 not found")
+	} else if *read != *ethernet {
+		t.Errorf("unexpected ethernet, expected: %+v, got: %+v", ethernet, read)
+	} else if read.MacAddress() != ethernet.Address {
+		t.Errorf("unexpected mac address: %s", read.MacAddress())
+	} else if vmx.Has("ethernet0.generatedAddress") {
+		t.Error("generated address not removed")
+	} else if !vmx.Has("ethernet0.uptCompatibility") {
+		t.Error("unknown key must be kept")
+	} else if ethernets := utils.ReadEthernets(vmx); len(ethernets) != 1 {
+		t.Errorf("unexpected ethernets: %v", ethernets)
+	}
+}
+
+func TestVMXSchemaValidation(t *testing.T) {
+	invalids := map[string]interface{ Validate() error }{
+		"virtualDev":      &utils.EthernetDevice{VirtualDev: "rtl8139"},
+		"connectionType":  &utils.EthernetDevice{ConnectionType: "wifi"},
+		"custom vnet":     &utils.EthernetDevice{ConnectionType: "custom"},
+		"static address":  &utils.EthernetDevice{AddressType: "static", Address: "00:50:56"},
+		"pci slot":        &utils.EthernetDevice{PciSlotNumber: 8192},
+		"card index":      &utils.EthernetDevice{Index: utils.MaxEthernetCards},
+		"disk controller": &utils.DiskDevice{Controller: "floppy", Present: true, FileName: "disk.vmdk"},
+		"disk scsi unit":  &utils.DiskDevice{Controller: "scsi", Unit: 7, Present: true, FileName: "disk.vmdk"},
+		"disk ide bus":    &utils.DiskDevice{Controller: "ide", Bus: 2, Present: true, FileName: "disk.vmdk"},
+		"disk file":       &utils.DiskDevice{Controller: "scsi", Present: true, FileName: "disk.iso"},
+		"cdrom type":      &utils.CDROM{Controller: "sata", DeviceType: "floppy"},
+		"serial type":     &utils.Serial{FileType: "usb"},
+		"serial file":     &utils.Serial{Present: true, FileType: "file"},
+		"firmware":        &utils.Firmware{Type: "coreboot"},
+		"secure boot":     &utils.Firmware{Type: "bios", SecureBoot: true},
+		"vcpus":           &utils.CPUMemory{MemoryInMb: 1024},
+		"cores":           &utils.CPUMemory{Vcpus: 3, CoresPerSocket: 2, MemoryInMb: 1024},
+		"memory":          &utils.CPUMemory{Vcpus: 1, MemoryInMb: 1023},
+	}
+
+	for name, device := range invalids {
+		if err := device.Validate(); err == nil {
+			t.Errorf("%s must be rejected: %+v", name, device)
+		}
+	}
+
+	valids := map[string]interface{ Validate() error }{
+		"ethernet": &utils.EthernetDevice{Index: 1, VirtualDev: "E1000E", ConnectionType: "nat", AddressType: "generated", PciSlotNumber: utils.PCISlotAuto},
+		"disk":     &utils.DiskDevice{Controller: "nvme", Unit: 1, Present: true, FileName: "disk.vmdk"},
+		"cdrom":    &utils.CDROM{Controller: "sata", Unit: 1, DeviceType: "cdrom-image", FileName: "seed.iso"},
+		"serial":   &utils.Serial{Present: true, FileType: "pipe", FileName: "/tmp/serial"},
+		"firmware": &utils.Firmware{Type: "efi", SecureBoot: true},
+		"cpu":      &utils.CPUMemory{Vcpus: 4, CoresPerSocket: 2, MemoryInMb: 4096},
+	}
+
+	for name, device := range valids {
+		if err := device.Validate(); err != nil {
+			t.Errorf("%s must be accepted: %v", name, err)
+		}
+	}
+}
+
+func TestVMXSchemaDevices(t *testing.T) {
+	vmx := utils.ParseVMX("sata0:0.present = \"TRUE\"\nsata0:0.fileName = \"disk.vmdk\"\nsata0:1.present = \"TRUE\"\nsata0:1.deviceType = \"cdrom-image\"\nsata0:1.fileName = \"seed.iso\"\n")
+
+	if disk, found := utils.ReadDisk(vmx, "sata", 0, 0); !found || disk.FileName != "disk.vmdk" {
+		t.Errorf("unexpected disk: %+v", disk)
+	} else if _, found := utils.ReadDisk(vmx, "sata", 0, 1); found {
+		t.Error("cdrom must not be read as a disk")
+	} else if cdrom, found := utils.ReadCDROM(vmx, "sata", 0, 1); !found || cdrom.FileName != "seed.iso" || !cdrom.Present {
+		t.Errorf("unexpected cdrom: %+v", cdrom)
+	} else if _, found := utils.ReadCDROM(vmx, "sata", 0, 0); found {
+		t.Error("disk must not be read as a cdrom")
+	}
+
+	sizing := &utils.CPUMemory{Vcpus: 4, CoresPerSocket: 2, MemoryInMb: 8192}
+	firmware := &utils.Firmware{Type: "efi", SecureBoot: true}
+	serial := &utils.Serial{Index: 1, Present: true, FileType: "file", FileName: "serial.log"}
+
+	if err := sizing.Write(vmx); err != nil {
+		t.Errorf("failed to write sizing: %v", err)
+	} else if read := utils.ReadCPUMemory(vmx); *read != *sizing {
+		t.Errorf("unexpected sizing: %+v", read)
+	} else if err = firmware.Write(vmx); err != nil {
+		t.Errorf("failed to write firmware: %v", err)
+	} else if read := utils.ReadFirmware(vmx); *read != *firmware {
+		t.Errorf("unexpected firmware: %+v", read)
+	} else if err = serial.Write(vmx); err != nil {
+		t.Errorf("failed to write serial: %v", err)
+	} else if read, found := utils.ReadSerial(vmx, 1); !found || *read != *serial {
+		t.Errorf("unexpected serial: %+v", read)
+	}
+}
+
+func TestVMXSchemaEthernetPCISlot(t *testing.T) {
+	vmx := utils.ParseVMX("sata0.pciSlotNumber = \"33\"\nnvme0.pciSlotNumber = \"1184\"\n")
+	slots := make(map[int]int)
+
+	for card := 0; card < utils.MaxEthernetCards; card++ {
+		if slot, err := utils.EthernetPCISlot(vmx, card); err != nil {
+			t.Errorf("failed to compute slot of ethernet%d: %v", card, err)
+		} else if !utils.ValidPCISlot(slot) || slot == 1184 {
+			t.Errorf("unexpected slot for ethernet%d: %d", card, slot)
+		} else if other, found := slots[slot]; found {
+			t.Errorf("ethernet%d and ethernet%d share the slot: %d", other, card, slot)
+		} else {
+			slots[slot] = card
+
+			vmx.Set(fmt.Sprintf("ethernet%d.pciSlotNumber", card), strconv.Itoa(slot))
+		}
+	}
+
+	for card, expected := range []int{160, 192, 161, 193, 225} {
+		if slot, _ := utils.EthernetPCISlot(vmx, card); slot != expected {
+			t.Errorf("unexpected slot for ethernet%d, expected: %d, got: %d", card, expected, slot)
+		}
+	}
+
+	if _, err := utils.EthernetPCISlot(vmx, utils.MaxEthernetCards); err == nil {
+		t.Error("card beyond the platform maximum must be rejected")
+	}
+}
@@ -0,0 +1,294 @@
+package service_test
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/Fred78290/vmware-desktop-autoscaler-utility/utils"
+)
+
+var updateGolden = flag.Bool("update", false, "update the golden files")
+
+// editVMX apply the same edits as a VM creation
+func editVMX(vmx *utils.VMXMap) {
+	vmx.Set("numvcpus", "4")
+	vmx.Set("MEMSIZE", "4096")
+	vmx.Set("displayName", vmx.Get("displayName"))
+	vmx.Cleanup(true)
+	vmx.Set("guestinfo.metadata.encoding", "gzip+base64")
+	vmx.Set("autostart", "TRUE")
+}
+
+func vmxTestFiles(t *testing.T) []string {
+	files, err := filepath.Glob(filepath.Join("testdata", "vmx", "*.vmx"))
+
+	if err != nil {
+		t.Fatal(err)
+	} else if len(files) == 0 {
+		t.Fatal("no vmx test files")
+	}
+
+	return files
+}
+
+// copyVMX copy a test file in a temporary directory
+func copyVMX(t *testing.T, file string) string {
+	target := filepath.Join(t.TempDir(), filepath.Base(file))
+
+	if content, err := os.ReadFile(file); err != nil {
+		t.Fatal(err)
+	} else if err = os.WriteFile(target, content, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	return target
+}
+
+func TestVMXRoundTrip(t *testing.T) {
+	for _, file := range vmxTestFiles(t) {
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			target := copyVMX(t, file)
+
+			if vmx, err := utils.LoadVMX(target); err != nil {
+				t.Fatalf("failed to load vmx: %v", err)
+			} else if err = vmx.Save(target); err != nil {
+				t.Fatalf("failed to save vmx: %v", err)
+			}
+
+			expected, _ := os.ReadFile(file)
+			got, _ := os.ReadFile(target)
+
+			if string(expected) != string(got) {
+				t.Errorf("round trip changed the file, expected:\n%q\ngot:\n%q", expected, got)
+			}
+		})
+	}
+}
+
+func TestVMXEditGolden(t *testing.T) {
+	for _, file := range vmxTestFiles(t) {
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			golden := strings.TrimSuffix(file, ".vmx") + ".golden"
+			target := copyVMX(t, file)
+
+			if vmx, err := utils.LoadVMX(target); err != nil {
+				t.Fatalf("failed to load vmx: %v", err)
+			} else {
+				editVMX(vmx)
+
+				if err = vmx.Save(target); err != nil {
+					t.Fatalf("failed to save vmx: %v", err)
+				}
+			}
+
+			got, _ := os.ReadFile(target)
+
+			if *updateGolden {
+				if err := os.WriteFile(golden, got, 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if expected, err := os.ReadFile(golden); err != nil {
+				t.Fatalf("failed to read golden file: %v", err)
+			} else if string(expected) != string(got) {
+				t.Errorf("unexpected vmx, expected:\n%s\ngot:\n%s", expected, got)
+			}
+		})
+	}
+}
+
+func TestVMXDuplicatedKeys(t *testing.T) {
+	target := filepath.Join(t.TempDir(), "duplicated.vmx")
+
+	if err := os.WriteFile(target, []byte("memsize = \"512\"\nnumvcpus = \"1\"\nmemsize = \"1024\"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if vmx, err := utils.LoadVMX(target); err != nil {
+		t.Fatalf("failed to load vmx: %v", err)
+	} else if value := vmx.Get("memsize"); value != "1024" {
+		t.Errorf("last occurrence must win, got: %s", value)
+	} else if keys := vmx.Keys(); len(keys) != 2 || keys[0] != "numvcpus" || keys[1] != "memsize" {
+		t.Errorf("unexpected keys: %v", keys)
+	} else if vmx.Delete("memsize"); vmx.String() != "numvcpus = \"1\"\n" {
+		t.Errorf("duplicated keys not deleted: %q", vmx.String())
+	}
+}
+
+func TestVMXLexer(t *testing.T) {
+	tests := []struct {
+		line  string
+		key   string
+		value string
+	}{
+		{line: `key="value"`, key: "key", value: "value"},
+		{line: `  key = "value"  `, key: "key", value: "value"},
+		{line: `key = unquoted`, key: "key", value: "unquoted"},
+		{line: `key = "a=b=c"`, key: "key", value: "a=b=c"},
+		{line: `key = "|22quoted|22 |7C pipe |23 hash"`, key: "key", value: `"quoted" | pipe # hash`},
+		{line: `key = "line|0Abreak"`, key: "key", value: "line\nbreak"},
+		{line: `key = "lone | pipe |zz"`, key: "key", value: "lone | pipe |zz"},
+		{line: `key = "{"legacy":"json"}"`, key: "key", value: `{"legacy":"json"}`},
+		{line: `key = ""`, key: "key", value: ""},
+		{line: `# key = "comment"`},
+		{line: `= "value"`},
+		{line: `no separator`},
+		{line: ``},
+	}
+
+	for _, test := range tests {
+		vmx := utils.ParseVMX(test.line + "\n")
+
+		if keys := vmx.Keys(); test.key == "" && len(keys) != 0 {
+			t.Errorf("%q must not be an entry, got: %v", test.line, keys)
+		} else if test.key != "" && (len(keys) != 1 || keys[0] != test.key) {
+			t.Errorf("%q unexpected keys: %v", test.line, keys)
+		} else if value := vmx.Get(test.key); test.key != "" && value != test.value {
+			t.Errorf("%q unexpected value, expected: %q, got: %q", test.line, test.value, value)
+		} else if vmx.String() != test.line+"\n" {
+			t.Errorf("%q not kept verbatim: %q", test.line, vmx.String())
+		}
+	}
+}
+
+func TestVMXGuestInfoRoundTrip(t *testing.T) {
+	values := []string{
+		"I2Nsb3VkLWNvbmZpZwpydW5jbWQ6CiAgLSBlY2hvICJoZWxsbyIK==",
+		`{"instance-id":"vm-1","local-hostname":"vm-1","network":{"version":2}}`,
+		"#cloud-config\nruncmd:\n  - echo \"|pipe|\"\n",
+	}
+
+	for _, value := range values {
+		vmx := utils.ParseVMX("")
+
+		vmx.Set("guestinfo.value", value)
+
+		if got := utils.ParseVMX(vmx.String()).Get("guestinfo.value"); got != value {
+			t.Errorf("value changed, expected: %q, got: %q", value, got)
+		}
+	}
+}
+
+func FuzzVMXValue(f *testing.F) {
+	f.Add("value")
+	f.Add(`{"key":"value"}`)
+	f.Add("a|22b|7C#\n\r\t")
+	f.Add("  spaces  ")
+
+	f.Fuzz(func(t *testing.T, value string) {
+		vmx := utils.ParseVMX("displayName = \"fuzz\"\n")
+
+		vmx.Set("guestinfo.fuzz", value)
+
+		content := vmx.String()
+		parsed := utils.ParseVMX(content)
+
+		if got := parsed.Get("guestinfo.fuzz"); got != value {
+			t.Errorf("value changed, expected: %q, got: %q", value, got)
+		} else if parsed.Get("displayName") != "fuzz" {
+			t.Errorf("other key changed: %q", content)
+		} else if parsed.String() != content {
+			t.Errorf("second round trip changed the content: %q", content)
+		}
+	})
+}
+
+func FuzzVMXParse(f *testing.F) {
+	for _, file := range []string{"ubuntu.vmx", "latin1.vmx", "crlf.vmx", "notrailing.vmx"} {
+		if content, err := os.ReadFile(filepath.Join("testdata", "vmx", file)); err == nil {
+			f.Add(string(content))
+		}
+	}
+
+	f.Fuzz(func(t *testing.T, content string) {
+		vmx := utils.ParseVMX(content)
+
+		if vmx.String() != content {
+			t.Errorf("round trip changed the content: %q", content)
+		}
+
+		// Rewrite every value, the result must parse to the same values
+		for _, key := range vmx.Keys() {
+			vmx.Set(key, vmx.Get(key)+"|")
+		}
+
+		parsed := utils.ParseVMX(vmx.String())
+
+		for _, key := range vmx.Keys() {
+			if parsed.Get(key) != vmx.Get(key) {
+				t.Errorf("value of %s changed, expected: %q, got: %q", key, vmx.Get(key), parsed.Get(key))
+			}
+		}
+	})
+}
+
+func TestVMXSaveBackups(t *testing.T) {
+	target := filepath.Join(t.TempDir(), "backup.vmx")
+	vmx := utils.ParseVMX("")
+	contents := make([]string, 0, utils.VMXBackupGenerations+2)
+
+	for i := 0; i < utils.VMXBackupGenerations+2; i++ {
+		vmx.Set("memsize", strconv.Itoa(512*(i+1)))
+
+		if err := vmx.Save(target); err != nil {
+			t.Fatalf("failed to save vmx: %v", err)
+		}
+
+		contents = append(contents, vmx.String())
+	}
+
+	// Unchanged content must not rotate the backups
+	if err := vmx.Save(target); err != nil {
+		t.Fatalf("failed to save vmx: %v", err)
+	}
+
+	backups := utils.VMXBackups(target)
+
+	if len(backups) != utils.VMXBackupGenerations {
+		t.Fatalf("unexpected backups count, expected: %d, got: %d", utils.VMXBackupGenerations, len(backups))
+	}
+
+	for generation := 1; generation <= utils.VMXBackupGenerations; generation++ {
+		if content, _ := os.ReadFile(utils.VMXBackupPath(target, generation)); string(content) != contents[len(contents)-1-generation] {
+			t.Errorf("unexpected content for generation %d: %q", generation, content)
+		}
+	}
+
+	if err := utils.RestoreVMX(target, 2); err != nil {
+		t.Fatalf("failed to restore vmx: %v", err)
+	} else if content, _ := os.ReadFile(target); string(content) != contents[len(contents)-3] {
+		t.Errorf("unexpected restored content: %q", content)
+	} else if err = utils.RestoreVMX(target, 1); err != nil {
+		t.Fatalf("failed to undo restore: %v", err)
+	} else if content, _ := os.ReadFile(target); string(content) != contents[len(contents)-1] {
+		t.Errorf("restore not undone: %q", content)
+	} else if err = utils.RestoreVMX(target, utils.VMXBackupGenerations+1); err == nil {
+		t.Error("restore beyond the kept generations must fail")
+	}
+
+	if leftovers, _ := filepath.Glob(filepath.Join(filepath.Dir(target), ".*.tmp*")); len(leftovers) != 0 {
+		t.Errorf("temporary files left: %v", leftovers)
+	}
+}
+
+func TestVMSDSaveWithoutBackups(t *testing.T) {
+	target := filepath.Join(t.TempDir(), "backup.vmsd")
+	vmsd := utils.ParseVMX("")
+
+	for i := 0; i < 3; i++ {
+		vmsd.Set("snapshot.numSnapshots", strconv.Itoa(i))
+
+		if err := vmsd.Save(target); err != nil {
+			t.Fatalf("failed to save vmsd: %v", err)
+		}
+	}
+
+	if backups, _ := filepath.Glob(target + ".bak*"); len(backups) != 0 {
+		t.Errorf("vmsd must not be backed up: %v", backups)
+	}
+}
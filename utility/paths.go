@@ -27,3 +27,8 @@ func DirectoryFor(thing string) string {
 func DirectoryForConfig(thing string) string {
 	return filepath.Join(directoryForConfig(), thing)
 }
+
+// DhcpConfig return the configuration of the VMware DHCP server of the vmnet
+func DhcpConfig(vmnet string) string {
+	return dhcpConfig(vmnet)
+}
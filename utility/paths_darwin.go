@@ -78,3 +78,7 @@ func directoryForConfig() string {
 		return home
 	}
 }
+
+func dhcpConfig(vmnet string) string {
+	return path.Join("/Library/Preferences/VMware Fusion", vmnet, "dhcpd.conf")
+}
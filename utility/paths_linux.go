@@ -64,3 +64,7 @@ func vmfolderDirectory() string {
 func directoryForConfig() string {
 	return certificatDirectory()
 }
+
+func dhcpConfig(vmnet string) string {
+	return path.Join("/etc/vmware", vmnet, "dhcpd", "dhcpd.conf")
+}
@@ -51,3 +51,8 @@ func vmfolderDirectory() string {
 func directoryForConfig() string {
 	return certificatDirectory()
 }
+
+// dhcpConfig is shared by all the vmnets on Windows
+func dhcpConfig(vmnet string) string {
+	return expandPath(filepath.Join("%systemdrive%", "ProgramData", "VMware", "vmnetdhcp.conf"))
+}
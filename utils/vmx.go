@@ -1,17 +1,33 @@
 package utils
 
 import (
-	"bufio"
+	"bytes"
 	"fmt"
 	"os"
-	"sort"
+	"strconv"
 	"strings"
 )
 
+// vmxEscape is the VMware escape character, it's followed by the hex code of the byte
+const vmxEscape = '|'
+
+// vmxLine is a line of the VMX, comments, blank and unknown lines are kept verbatim
+type vmxLine struct {
+	raw     string
+	key     string
+	value   string
+	entry   bool
+	dirty   bool
+	deleted bool
+}
+
+// VMXMap is a line oriented VMX document, the ordering, comments and encoding of the file are preserved.
+// Values are edited in place and new keys are appended at the end.
 type VMXMap struct {
-	headline string
-	vmx      map[string]string
-	keys     map[string]string
+	lines    []*vmxLine
+	keys     map[string]*vmxLine
+	newline  string
+	trailing bool
 }
 
 func (vmx *VMXMap) Cleanup(removeCard bool) {
@@ -38,125 +54,227 @@ func (vmx *VMXMap) Has(key string) bool {
 }
 
 func (vmx *VMXMap) Get(key string) string {
-	if real, found := vmx.keys[strings.ToLower(key)]; found {
-		return vmx.vmx[real]
+	if line, found := vmx.keys[strings.ToLower(key)]; found {
+		return line.value
 	}
 
 	return ""
 }
 
 func (vmx *VMXMap) Delete(key string) string {
-	if real, found := vmx.keys[strings.ToLower(key)]; found {
-		delete(vmx.vmx, real)
-	}
-
-	return ""
-}
+	lower := strings.ToLower(key)
 
-func (vmx *VMXMap) sortedKeys() []string {
-	result := make([]string, 0, len(vmx.keys))
+	if _, found := vmx.keys[lower]; found {
+		// Remove the duplicated keys too, they would take effect again
+		for _, line := range vmx.lines {
+			if line.entry && strings.ToLower(line.key) == lower {
+				line.deleted = true
+			}
+		}
 
-	for k := range vmx.vmx {
-		result = append(result, k)
+		delete(vmx.keys, lower)
 	}
 
-	sort.Strings(result)
-
-	return result
+	return ""
 }
 
+// Keys return the lower case keys in the file order
 func (vmx *VMXMap) Keys() []string {
 	result := make([]string, 0, len(vmx.keys))
 
-	for k := range vmx.keys {
-		result = append(result, k)
+	for _, line := range vmx.lines {
+		if line.entry && !line.deleted {
+			if lower := strings.ToLower(line.key); vmx.keys[lower] == line {
+				result = append(result, lower)
+			}
+		}
 	}
 
 	return result
 }
 
 func (vmx *VMXMap) Set(key, value string) {
-	if real, found := vmx.keys[strings.ToLower(key)]; found {
-		vmx.vmx[real] = value
+	lower := strings.ToLower(key)
+
+	if line, found := vmx.keys[lower]; found {
+		if line.value != value {
+			line.value = value
+			line.dirty = true
+		}
 	} else {
-		lower := strings.ToLower(key)
+		line := &vmxLine{
+			key:   key,
+			value: value,
+			entry: true,
+			dirty: true,
+		}
 
-		vmx.keys[lower] = key
-		vmx.vmx[key] = value
+		vmx.lines = append(vmx.lines, line)
+		vmx.keys[lower] = line
 	}
 }
 
-func (vmx *VMXMap) Load(vmxpath string) error {
+func (vmx *VMXMap) parse(content string) {
+	vmx.lines = vmx.lines[:0]
+	vmx.keys = make(map[string]*vmxLine)
+	vmx.newline = "\n"
+	vmx.trailing = len(content) == 0 || strings.HasSuffix(content, "\n")
 
-	if file, err := os.Open(vmxpath); err != nil {
-		return err
-	} else {
-		defer file.Close()
+	if strings.Contains(content, "\r\n") {
+		vmx.newline = "\r\n"
+	}
 
-		fileScanner := bufio.NewScanner(file)
+	if len(content) == 0 {
+		return
+	}
 
-		fileScanner.Split(bufio.ScanLines)
+	for _, raw := range strings.Split(strings.TrimSuffix(content, "\n"), "\n") {
+		line := &vmxLine{
+			raw: raw,
+		}
 
-		count := 0
+		if key, value, ok := lexLine(raw); ok {
+			line.entry = true
+			line.key = key
+			line.value = value
 
-		for fileScanner.Scan() {
-			line := fileScanner.Text()
+			// The last occurrence win like VMware does
+			vmx.keys[strings.ToLower(key)] = line
+		}
 
-			if strings.HasPrefix(line, "#!") {
-				if count == 0 {
-					vmx.headline = line
-				}
-			} else if !strings.HasPrefix(line, ".encoding") && !strings.HasPrefix(line, "#") {
-				offset := strings.Index(line, "=")
+		vmx.lines = append(vmx.lines, line)
+	}
+}
 
-				if offset >= 0 {
-					key := strings.TrimSpace(line[:offset-1])
-					value := strings.Trim(strings.TrimSpace(line[offset+1:]), "\"")
+// String render the document, untouched lines are written verbatim
+func (vmx *VMXMap) String() string {
+	var buffer bytes.Buffer
+
+	for i, line := range vmx.lines {
+		if line.deleted {
+			continue
+		} else if line.dirty {
+			buffer.WriteString(fmt.Sprintf("%s = \"%s\"", line.key, escapeValue(line.value)))
+		} else {
+			buffer.WriteString(line.raw)
+		}
 
-					vmx.vmx[key] = value
-					vmx.keys[strings.ToLower(key)] = key
-				} else {
-					fmt.Printf("Drop line:%s\n", line)
-				}
+		if i < len(vmx.lines)-1 || vmx.trailing {
+			if line.dirty && vmx.newline == "\r\n" {
+				buffer.WriteString("\r\n")
+			} else {
+				buffer.WriteString("\n")
 			}
-
-			count++
 		}
+	}
+
+	return buffer.String()
+}
+
+func (vmx *VMXMap) Load(vmxpath string) error {
+	if content, err := os.ReadFile(vmxpath); err != nil {
+		return err
+	} else {
+		vmx.parse(string(content))
 
 		return nil
 	}
 }
 
+// Save write the VMX atomically, the previous content of a .vmx file is kept as a backup generation.
+// Other files using the VMX format like the .vmsd are written without backup.
 func (vmx *VMXMap) Save(vmxpath string) error {
-	if file, err := os.OpenFile(vmxpath, os.O_WRONLY|os.O_TRUNC, 0644); err != nil {
-		return err
-	} else {
-		datawriter := bufio.NewWriter(file)
+	return writeVMX(vmxpath, []byte(vmx.String()))
+}
 
-		if vmx.headline != "" {
-			datawriter.WriteString(fmt.Sprintf("%s\n", vmx.headline))
-		}
+// ParseVMX build a document from the content of a VMX
+func ParseVMX(content string) *VMXMap {
+	vmx := &VMXMap{
+		keys: make(map[string]*vmxLine),
+	}
+
+	vmx.parse(content)
+
+	return vmx
+}
+
+func LoadVMX(vmxpath string) (*VMXMap, error) {
+	vmx := &VMXMap{
+		keys: make(map[string]*vmxLine),
+	}
+
+	return vmx, vmx.Load(vmxpath)
+}
+
+// lexLine split a VMX line in key and value, comments, blank and malformed lines aren't entries.
+// The quoted value end at the last quote of the line, so unescaped quotes written by hand survive.
+func lexLine(raw string) (key, value string, ok bool) {
+	text := strings.TrimSpace(raw)
+
+	if len(text) == 0 || text[0] == '#' {
+		return "", "", false
+	}
+
+	offset := strings.IndexByte(text, '=')
 
-		datawriter.WriteString(".encoding = \"UTF-8\"\n")
+	if offset <= 0 {
+		return "", "", false
+	}
+
+	if key = strings.TrimSpace(text[:offset]); strings.ContainsAny(key, " \t\"") {
+		return "", "", false
+	}
 
-		sortedKeys := vmx.sortedKeys()
+	value = strings.TrimSpace(text[offset+1:])
 
-		for _, k := range sortedKeys {
-			datawriter.WriteString(fmt.Sprintf("%s = \"%s\"\n", k, vmx.vmx[k]))
+	if len(value) > 0 && value[0] == '"' {
+		if last := strings.LastIndexByte(value, '"'); last > 0 {
+			value = value[1:last]
+		} else {
+			value = value[1:]
 		}
+	}
+
+	return key, unescapeValue(value), true
+}
+
+// unescapeValue decode the |XX sequences, a lone | is kept as is
+func unescapeValue(value string) string {
+	if strings.IndexByte(value, vmxEscape) < 0 {
+		return value
+	}
+
+	var buffer strings.Builder
 
-		datawriter.Flush()
-		file.Close()
+	for i := 0; i < len(value); i++ {
+		if value[i] == vmxEscape && i+2 < len(value) && isHex(value[i+1]) && isHex(value[i+2]) {
+			b, _ := strconv.ParseUint(value[i+1:i+3], 16, 8)
+
+			buffer.WriteByte(byte(b))
+			i += 2
+		} else {
+			buffer.WriteByte(value[i])
+		}
 	}
 
-	return nil
+	return buffer.String()
 }
 
-func LoadVMX(vmxpath string) (*VMXMap, error) {
-	vmx := &VMXMap{
-		keys: make(map[string]string),
-		vmx:  make(map[string]string),
+// escapeValue encode the bytes VMware doesn't accept in a quoted value
+func escapeValue(value string) string {
+	var buffer strings.Builder
+
+	for i := 0; i < len(value); i++ {
+		if c := value[i]; c < 0x20 || c == 0x7f || c == '"' || c == '#' || c == vmxEscape {
+			buffer.WriteString(fmt.Sprintf("%c%02X", vmxEscape, c))
+		} else {
+			buffer.WriteByte(c)
+		}
 	}
 
-	return vmx, vmx.Load(vmxpath)
+	return buffer.String()
+}
+
+func isHex(c byte) bool {
+	return (c >= '0' && c <= '9') || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F')
 }
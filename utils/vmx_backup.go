@@ -0,0 +1,146 @@
+package utils
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// VMXBackupGenerations is the count of previous VMX contents kept next to the VMX
+const VMXBackupGenerations = 5
+
+// VMXBackupPath return the path of a backup generation, the generation 1 is the content before the last edit
+func VMXBackupPath(vmxpath string, generation int) string {
+	if generation <= 1 {
+		return vmxpath + ".bak"
+	}
+
+	return fmt.Sprintf("%s.bak.%d", vmxpath, generation)
+}
+
+// VMXBackups return the existing backups from the most recent
+func VMXBackups(vmxpath string) []string {
+	backups := make([]string, 0, VMXBackupGenerations)
+
+	for generation := 1; generation <= VMXBackupGenerations; generation++ {
+		if backup := VMXBackupPath(vmxpath, generation); FileExists(backup) {
+			backups = append(backups, backup)
+		} else {
+			break
+		}
+	}
+
+	return backups
+}
+
+// RemoveVMXBackups delete the backups of a deleted VM
+func RemoveVMXBackups(vmxpath string) {
+	for generation := 1; generation <= VMXBackupGenerations; generation++ {
+		os.Remove(VMXBackupPath(vmxpath, generation))
+	}
+}
+
+// RestoreVMX roll back the last generations edits, the restore is itself an edit and could be rolled back
+func RestoreVMX(vmxpath string, generations int) error {
+	if generations < 1 || generations > VMXBackupGenerations {
+		return fmt.Errorf("invalid backup generation: %d, expected between 1 and %d", generations, VMXBackupGenerations)
+	}
+
+	backup := VMXBackupPath(vmxpath, generations)
+
+	if content, err := os.ReadFile(backup); err != nil {
+		return fmt.Errorf("unable to read backup: %s, reason: %v", backup, err)
+	} else {
+		return writeVMX(vmxpath, content)
+	}
+}
+
+// rotateVMXBackups shift the backups generations and copy the current content as the most recent
+func rotateVMXBackups(vmxpath string, current []byte, perm os.FileMode) error {
+	os.Remove(VMXBackupPath(vmxpath, VMXBackupGenerations))
+
+	for generation := VMXBackupGenerations - 1; generation >= 1; generation-- {
+		if backup := VMXBackupPath(vmxpath, generation); FileExists(backup) {
+			if err := os.Rename(backup, VMXBackupPath(vmxpath, generation+1)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return writeFileSync(VMXBackupPath(vmxpath, 1), current, perm)
+}
+
+// writeFileSync write the content in a temporary file flushed to the disk then rename it over the target
+func writeFileSync(target string, content []byte, perm os.FileMode) error {
+	dir, name := filepath.Split(target)
+
+	file, err := os.CreateTemp(dir, "."+name+".tmp*")
+
+	if err != nil {
+		return err
+	}
+
+	temp := file.Name()
+
+	if _, err = file.Write(content); err == nil {
+		if err = file.Sync(); err == nil {
+			err = file.Chmod(perm)
+		}
+	}
+
+	if e := file.Close(); err == nil {
+		err = e
+	}
+
+	if err == nil {
+		err = os.Rename(temp, target)
+	}
+
+	if err != nil {
+		os.Remove(temp)
+
+		return err
+	}
+
+	syncDir(dir)
+
+	return nil
+}
+
+// writeVMX replace the VMX atomically, nothing is written when the content is unchanged
+func writeVMX(vmxpath string, content []byte) error {
+	perm := os.FileMode(0644)
+
+	if info, err := os.Stat(vmxpath); err == nil {
+		perm = info.Mode().Perm()
+
+		if current, err := os.ReadFile(vmxpath); err != nil {
+			return err
+		} else if bytes.Equal(current, content) {
+			return nil
+		} else if strings.EqualFold(filepath.Ext(vmxpath), ".vmx") {
+			// Only the VMX can be restored, the other files like the .vmsd aren't backed up
+			if err = rotateVMXBackups(vmxpath, current, perm); err != nil {
+				return fmt.Errorf("unable to backup: %s, reason: %v", vmxpath, err)
+			}
+		}
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+
+	return writeFileSync(vmxpath, content, perm)
+}
+
+// syncDir flush the directory entry of a renamed file, not supported on every platform so errors are ignored
+func syncDir(dir string) {
+	if dir == "" {
+		dir = "."
+	}
+
+	if file, err := os.Open(dir); err == nil {
+		file.Sync()
+		file.Close()
+	}
+}
@@ -0,0 +1,50 @@
+package utils
+
+// VMXChange is a key whose value differ between two VMX
+type VMXChange struct {
+	Key   string `json:"key"`
+	Value string `json:"value,omitempty"`
+	Old   string `json:"old,omitempty"`
+}
+
+// VMXDiff list the keys added, changed and removed between two VMX, in the file order
+type VMXDiff struct {
+	Added   []VMXChange `json:"added"`
+	Changed []VMXChange `json:"changed"`
+	Removed []VMXChange `json:"removed"`
+}
+
+// Empty return true if the two VMX hold the same values
+func (diff *VMXDiff) Empty() bool {
+	return len(diff.Added) == 0 && len(diff.Changed) == 0 && len(diff.Removed) == 0
+}
+
+// Clone return an independent copy of the document
+func (vmx *VMXMap) Clone() *VMXMap {
+	return ParseVMX(vmx.String())
+}
+
+// DiffVMX compare the values of two VMX, keys are compared case insensitive and reported in lower case
+func DiffVMX(before, after *VMXMap) *VMXDiff {
+	diff := &VMXDiff{
+		Added:   []VMXChange{},
+		Changed: []VMXChange{},
+		Removed: []VMXChange{},
+	}
+
+	for _, key := range before.Keys() {
+		if !after.Has(key) {
+			diff.Removed = append(diff.Removed, VMXChange{Key: key, Old: before.Get(key)})
+		} else if value := after.Get(key); value != before.Get(key) {
+			diff.Changed = append(diff.Changed, VMXChange{Key: key, Value: value, Old: before.Get(key)})
+		}
+	}
+
+	for _, key := range after.Keys() {
+		if !before.Has(key) {
+			diff.Added = append(diff.Added, VMXChange{Key: key, Value: after.Get(key)})
+		}
+	}
+
+	return diff
+}
@@ -0,0 +1,528 @@
+package utils
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+const (
+	// MaxEthernetCards is the count of network adapters supported by VMware
+	MaxEthernetCards = 10
+	// MaxSerialPorts is the count of serial ports supported by VMware
+	MaxSerialPorts = 4
+	// PCISlotAuto let VMware choose the PCI slot
+	PCISlotAuto = -1
+	// PCISlotMin and PCISlotMax bound the slot numbers, the bits are function:3 bus:5 device:5
+	PCISlotMin = 32
+	PCISlotMax = 8191
+)
+
+// DiskControllerUnits is the max units by controller, scsi unit 7 is reserved for the controller itself
+var DiskControllerUnits = map[string]int{
+	"nvme": 15,
+	"scsi": 16,
+	"sata": 30,
+	"ide":  2,
+}
+
+// DiskControllerBuses is the count of buses by controller, like scsi0 to scsi3, IDE only has a primary and a secondary channel
+var DiskControllerBuses = map[string]int{
+	"nvme": 4,
+	"scsi": 4,
+	"sata": 4,
+	"ide":  2,
+}
+
+var ethernetVirtualDevs = []string{"e1000", "e1000e", "vlance", "vmxnet", "vmxnet3"}
+var ethernetConnectionTypes = []string{"bridged", "custom", "hostonly", "nat"}
+var ethernetAddressTypes = []string{"generated", "static", "vpx"}
+var cdromDeviceTypes = []string{"atapi-cdrom", "cdrom-image", "cdrom-raw"}
+var serialFileTypes = []string{"device", "file", "network", "pipe"}
+var firmwareTypes = []string{"bios", "efi"}
+
+// EthernetDevice is the typed view of the ethernetN keys
+type EthernetDevice struct {
+	Index                  int
+	Present                bool
+	VirtualDev             string
+	ConnectionType         string
+	Vnet                   string
+	AddressType            string
+	Address                string
+	GeneratedAddress       string
+	GeneratedAddressOffset int
+	LinkStatePropagation   bool
+	PciSlotNumber          int
+	BsdName                string
+	DisplayName            string
+}
+
+// DiskDevice is the typed view of a virtual disk attached to a controller
+type DiskDevice struct {
+	Controller string
+	Bus        int
+	Unit       int
+	Present    bool
+	FileName   string
+	Mode       string
+}
+
+// CDROM is the typed view of a CD-ROM attached to a controller
+type CDROM struct {
+	Controller     string
+	Bus            int
+	Unit           int
+	Present        bool
+	DeviceType     string
+	FileName       string
+	StartConnected bool
+}
+
+// Serial is the typed view of the serialN keys
+type Serial struct {
+	Index          int
+	Present        bool
+	FileType       string
+	FileName       string
+	StartConnected bool
+}
+
+// Firmware is the typed view of the boot firmware
+type Firmware struct {
+	Type       string
+	SecureBoot bool
+}
+
+// CPUMemory is the typed view of the sizing of the VM
+type CPUMemory struct {
+	Vcpus          int
+	CoresPerSocket int
+	MemoryInMb     int
+}
+
+func oneOf(kind, value string, allowed []string) error {
+	for _, candidate := range allowed {
+		if strings.EqualFold(candidate, value) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("invalid %s: %s, expected one of: %s", kind, value, strings.Join(allowed, ", "))
+}
+
+// setOrDelete remove the key when the value is empty so VMware apply its default
+func setOrDelete(vmx *VMXMap, key, value string) {
+	if value == "" {
+		vmx.Delete(key)
+	} else {
+		vmx.Set(key, value)
+	}
+}
+
+func setOrDeleteInt(vmx *VMXMap, key string, value int) {
+	if value == 0 {
+		vmx.Delete(key)
+	} else {
+		vmx.Set(key, strconv.Itoa(value))
+	}
+}
+
+// ValidPCISlot check a slot number, zero means not set
+func ValidPCISlot(slot int) bool {
+	return slot == 0 || slot == PCISlotAuto || (slot >= PCISlotMin && slot <= PCISlotMax)
+}
+
+// ethernetPCISlots are the slots VMware give to the first ethernet cards, on the devices of pciBridge4 to pciBridge7
+var ethernetPCISlots = []int{160, 192, 161, 193, 225}
+
+// pciFunctions is the count of functions of the bridges, pciBridgeN.functions = "8"
+const pciFunctions = 8
+
+// ethernetPCISlot return the nth slot VMware use for ethernet cards, the next cards take the following functions of the same devices
+func ethernetPCISlot(nth int) int {
+	return ethernetPCISlots[nth%len(ethernetPCISlots)] | (nth/len(ethernetPCISlots))<<10
+}
+
+// EthernetPCISlot compute the PCI slot number of the ethernet card, slots used by the other devices of the VMX are skipped
+func EthernetPCISlot(vmx *VMXMap, card int) (int, error) {
+	if card < 0 || card >= MaxEthernetCards {
+		return 0, fmt.Errorf("invalid ethernet card: %d, expected between 0 and %d", card, MaxEthernetCards-1)
+	}
+
+	used := make(map[int]bool)
+	prefix := fmt.Sprintf("ethernet%d.", card)
+
+	for _, key := range vmx.Keys() {
+		if strings.HasSuffix(key, ".pcislotnumber") && !strings.HasPrefix(key, prefix) {
+			used[StrToInt(vmx.Get(key))] = true
+		}
+	}
+
+	for nth := card; nth < len(ethernetPCISlots)*pciFunctions; nth++ {
+		if slot := ethernetPCISlot(nth); !used[slot] {
+			return slot, nil
+		}
+	}
+
+	return 0, fmt.Errorf("no free PCI slot for ethernet%d", card)
+}
+
+func (e *EthernetDevice) prefix() string {
+	return fmt.Sprintf("ethernet%d.", e.Index)
+}
+
+// ReadEthernet return the network adapter of a card, found is false when the card isn't defined
+func ReadEthernet(vmx *VMXMap, index int) (*EthernetDevice, bool) {
+	e := &EthernetDevice{
+		Index: index,
+	}
+
+	prefix := e.prefix()
+
+	if !vmx.Has(prefix + "present") {
+		return nil, false
+	}
+
+	e.Present = StrToBool(vmx.Get(prefix + "present"))
+	e.VirtualDev = vmx.Get(prefix + "virtualDev")
+	e.ConnectionType = vmx.Get(prefix + "connectionType")
+	e.Vnet = vmx.Get(prefix + "vnet")
+	e.AddressType = vmx.Get(prefix + "addressType")
+	e.Address = vmx.Get(prefix + "address")
+	e.GeneratedAddress = vmx.Get(prefix + "generatedAddress")
+	e.GeneratedAddressOffset = StrToInt(vmx.Get(prefix + "generatedAddressOffset"))
+	e.LinkStatePropagation = StrToBool(vmx.Get(prefix + "linkStatePropagation.enable"))
+	e.PciSlotNumber = StrToInt(vmx.Get(prefix + "pciSlotNumber"))
+	e.BsdName = vmx.Get(prefix + "bsdName")
+	e.DisplayName = vmx.Get(prefix + "displayName")
+
+	return e, true
+}
+
+// ReadEthernets return the defined network adapters ordered by card
+func ReadEthernets(vmx *VMXMap) []*EthernetDevice {
+	result := make([]*EthernetDevice, 0, MaxEthernetCards)
+
+	for index := 0; index < MaxEthernetCards; index++ {
+		if e, found := ReadEthernet(vmx, index); found {
+			result = append(result, e)
+		}
+	}
+
+	return result
+}
+
+// MacAddress return the address in use, generated or static
+func (e *EthernetDevice) MacAddress() string {
+	if strings.EqualFold(e.AddressType, "generated") {
+		return e.GeneratedAddress
+	}
+
+	return e.Address
+}
+
+func (e *EthernetDevice) Validate() error {
+	if e.Index < 0 || e.Index >= MaxEthernetCards {
+		return fmt.Errorf("invalid ethernet card: %d, expected between 0 and %d", e.Index, MaxEthernetCards-1)
+	}
+
+	checks := []struct {
+		kind    string
+		value   string
+		allowed []string
+	}{
+		{kind: "virtualDev", value: e.VirtualDev, allowed: ethernetVirtualDevs},
+		{kind: "connectionType", value: e.ConnectionType, allowed: ethernetConnectionTypes},
+		{kind: "addressType", value: e.AddressType, allowed: ethernetAddressTypes},
+	}
+
+	for _, check := range checks {
+		if check.value != "" {
+			if err := oneOf(check.kind, check.value, check.allowed); err != nil {
+				return fmt.Errorf("%v on ethernet%d", err, e.Index)
+			}
+		}
+	}
+
+	if strings.EqualFold(e.ConnectionType, "custom") && e.Vnet == "" {
+		return fmt.Errorf("vnet is required for custom connection on ethernet%d", e.Index)
+	} else if strings.EqualFold(e.AddressType, "static") {
+		if _, err := net.ParseMAC(e.Address); err != nil {
+			return fmt.Errorf("invalid static address: %s on ethernet%d", e.Address, e.Index)
+		}
+	}
+
+	if !ValidPCISlot(e.PciSlotNumber) {
+		return fmt.Errorf("invalid pciSlotNumber: %d on ethernet%d, expected between %d and %d", e.PciSlotNumber, e.Index, PCISlotMin, PCISlotMax)
+	}
+
+	return nil
+}
+
+// Write validate the adapter and store it, empty fields remove their keys
+func (e *EthernetDevice) Write(vmx *VMXMap) error {
+	if err := e.Validate(); err != nil {
+		return err
+	}
+
+	prefix := e.prefix()
+
+	vmx.Set(prefix+"present", BoolToStr(e.Present))
+	setOrDelete(vmx, prefix+"virtualDev", e.VirtualDev)
+	setOrDelete(vmx, prefix+"connectionType", e.ConnectionType)
+	setOrDelete(vmx, prefix+"vnet", e.Vnet)
+	setOrDelete(vmx, prefix+"addressType", e.AddressType)
+	setOrDelete(vmx, prefix+"address", e.Address)
+	setOrDelete(vmx, prefix+"generatedAddress", e.GeneratedAddress)
+	setOrDeleteInt(vmx, prefix+"generatedAddressOffset", e.GeneratedAddressOffset)
+	setOrDeleteInt(vmx, prefix+"pciSlotNumber", e.PciSlotNumber)
+	setOrDelete(vmx, prefix+"bsdName", e.BsdName)
+	setOrDelete(vmx, prefix+"displayName", e.DisplayName)
+
+	if e.LinkStatePropagation {
+		vmx.Set(prefix+"linkStatePropagation.enable", "TRUE")
+	} else {
+		vmx.Delete(prefix + "linkStatePropagation.enable")
+	}
+
+	return nil
+}
+
+// Slot return the device name like scsi0:1
+func (d *DiskDevice) Slot() string {
+	return fmt.Sprintf("%s%d:%d", d.Controller, d.Bus, d.Unit)
+}
+
+// ReadDisk return the virtual disk attached to a slot, found is false when the slot isn't a present disk
+func ReadDisk(vmx *VMXMap, controller string, bus, unit int) (*DiskDevice, bool) {
+	d := &DiskDevice{
+		Controller: controller,
+		Bus:        bus,
+		Unit:       unit,
+	}
+
+	slot := d.Slot()
+
+	if !StrToBool(vmx.Get(slot+".present")) || strings.HasPrefix(strings.ToLower(vmx.Get(slot+".deviceType")), "cdrom") {
+		return nil, false
+	}
+
+	d.Present = true
+	d.FileName = vmx.Get(slot + ".fileName")
+	d.Mode = vmx.Get(slot + ".mode")
+
+	return d, true
+}
+
+func validSlot(controller string, bus, unit int) error {
+	if units, found := DiskControllerUnits[controller]; !found {
+		return fmt.Errorf("unsupported disk controller: %s", controller)
+	} else if bus < 0 || bus >= DiskControllerBuses[controller] {
+		return fmt.Errorf("invalid bus: %d for controller: %s", bus, controller)
+	} else if unit < 0 || unit >= units || (controller == "scsi" && unit == 7) {
+		return fmt.Errorf("invalid unit: %d for controller: %s", unit, controller)
+	}
+
+	return nil
+}
+
+func (d *DiskDevice) Validate() error {
+	if err := validSlot(d.Controller, d.Bus, d.Unit); err != nil {
+		return err
+	} else if d.Present && !strings.HasSuffix(strings.ToLower(d.FileName), ".vmdk") {
+		return fmt.Errorf("invalid disk file: %s on %s", d.FileName, d.Slot())
+	}
+
+	return nil
+}
+
+func (d *DiskDevice) Write(vmx *VMXMap) error {
+	if err := d.Validate(); err != nil {
+		return err
+	}
+
+	slot := d.Slot()
+
+	vmx.Set(slot+".present", BoolToStr(d.Present))
+	setOrDelete(vmx, slot+".fileName", d.FileName)
+	setOrDelete(vmx, slot+".mode", d.Mode)
+	vmx.Delete(slot + ".deviceType")
+
+	return nil
+}
+
+func (c *CDROM) Slot() string {
+	return fmt.Sprintf("%s%d:%d", c.Controller, c.Bus, c.Unit)
+}
+
+// ReadCDROM return the CD-ROM attached to a slot, found is false when the slot isn't a CD-ROM
+func ReadCDROM(vmx *VMXMap, controller string, bus, unit int) (*CDROM, bool) {
+	c := &CDROM{
+		Controller: controller,
+		Bus:        bus,
+		Unit:       unit,
+	}
+
+	slot := c.Slot()
+
+	if c.DeviceType = vmx.Get(slot + ".deviceType"); oneOf("deviceType", c.DeviceType, cdromDeviceTypes) != nil {
+		return nil, false
+	}
+
+	c.Present = StrToBool(vmx.Get(slot + ".present"))
+	c.FileName = vmx.Get(slot + ".fileName")
+	c.StartConnected = StrToBool(vmx.Get(slot + ".startConnected"))
+
+	return c, true
+}
+
+func (c *CDROM) Validate() error {
+	if err := validSlot(c.Controller, c.Bus, c.Unit); err != nil {
+		return err
+	}
+
+	return oneOf("deviceType", c.DeviceType, cdromDeviceTypes)
+}
+
+func (c *CDROM) Write(vmx *VMXMap) error {
+	if err := c.Validate(); err != nil {
+		return err
+	}
+
+	slot := c.Slot()
+
+	vmx.Set(slot+".present", BoolToStr(c.Present))
+	vmx.Set(slot+".deviceType", c.DeviceType)
+	setOrDelete(vmx, slot+".fileName", c.FileName)
+	vmx.Set(slot+".startConnected", BoolToStr(c.StartConnected))
+
+	return nil
+}
+
+func (s *Serial) prefix() string {
+	return fmt.Sprintf("serial%d.", s.Index)
+}
+
+// ReadSerial return the serial port, found is false when the port isn't defined
+func ReadSerial(vmx *VMXMap, index int) (*Serial, bool) {
+	s := &Serial{
+		Index: index,
+	}
+
+	prefix := s.prefix()
+
+	if !vmx.Has(prefix + "present") {
+		return nil, false
+	}
+
+	s.Present = StrToBool(vmx.Get(prefix + "present"))
+	s.FileType = vmx.Get(prefix + "fileType")
+	s.FileName = vmx.Get(prefix + "fileName")
+	s.StartConnected = StrToBool(vmx.Get(prefix + "startConnected"))
+
+	return s, true
+}
+
+func (s *Serial) Validate() error {
+	if s.Index < 0 || s.Index >= MaxSerialPorts {
+		return fmt.Errorf("invalid serial port: %d, expected between 0 and %d", s.Index, MaxSerialPorts-1)
+	} else if s.Present && s.FileName == "" {
+		return fmt.Errorf("fileName is required for serial%d", s.Index)
+	}
+
+	return oneOf("fileType", s.FileType, serialFileTypes)
+}
+
+func (s *Serial) Write(vmx *VMXMap) error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+
+	prefix := s.prefix()
+
+	vmx.Set(prefix+"present", BoolToStr(s.Present))
+	vmx.Set(prefix+"fileType", s.FileType)
+	setOrDelete(vmx, prefix+"fileName", s.FileName)
+	vmx.Set(prefix+"startConnected", BoolToStr(s.StartConnected))
+
+	return nil
+}
+
+// ReadFirmware return the boot firmware, an empty type is the VMware default bios
+func ReadFirmware(vmx *VMXMap) *Firmware {
+	return &Firmware{
+		Type:       vmx.Get("firmware"),
+		SecureBoot: StrToBool(vmx.Get("uefi.secureBoot.enabled")),
+	}
+}
+
+func (f *Firmware) Validate() error {
+	if f.Type != "" {
+		if err := oneOf("firmware", f.Type, firmwareTypes); err != nil {
+			return err
+		}
+	}
+
+	if f.SecureBoot && !strings.EqualFold(f.Type, "efi") {
+		return fmt.Errorf("secure boot requires efi firmware")
+	}
+
+	return nil
+}
+
+func (f *Firmware) Write(vmx *VMXMap) error {
+	if err := f.Validate(); err != nil {
+		return err
+	}
+
+	setOrDelete(vmx, "firmware", f.Type)
+
+	if f.SecureBoot {
+		vmx.Set("uefi.secureBoot.enabled", "TRUE")
+	} else {
+		vmx.Delete("uefi.secureBoot.enabled")
+	}
+
+	return nil
+}
+
+// ReadCPUMemory return the sizing, a missing numvcpus is the VMware default of one vcpu
+func ReadCPUMemory(vmx *VMXMap) *CPUMemory {
+	c := &CPUMemory{
+		Vcpus:          StrToInt(vmx.Get("numvcpus")),
+		CoresPerSocket: StrToInt(vmx.Get("cpuid.coresPerSocket")),
+		MemoryInMb:     StrToInt(vmx.Get("memsize")),
+	}
+
+	if c.Vcpus == 0 {
+		c.Vcpus = 1
+	}
+
+	return c
+}
+
+func (c *CPUMemory) Validate() error {
+	if c.Vcpus < 1 {
+		return fmt.Errorf("invalid vcpus: %d", c.Vcpus)
+	} else if c.CoresPerSocket < 0 || (c.CoresPerSocket > 0 && c.Vcpus%c.CoresPerSocket != 0) {
+		return fmt.Errorf("invalid cores per socket: %d for %d vcpus", c.CoresPerSocket, c.Vcpus)
+	} else if c.MemoryInMb < 4 || c.MemoryInMb%4 != 0 {
+		return fmt.Errorf("invalid memory: %dMB, expected a multiple of 4MB", c.MemoryInMb)
+	}
+
+	return nil
+}
+
+func (c *CPUMemory) Write(vmx *VMXMap) error {
+	if err := c.Validate(); err != nil {
+		return err
+	}
+
+	vmx.Set("numvcpus", strconv.Itoa(c.Vcpus))
+	setOrDeleteInt(vmx, "cpuid.coresPerSocket", c.CoresPerSocket)
+	vmx.Set("memsize", strconv.Itoa(c.MemoryInMb))
+
+	return nil
+}